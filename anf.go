@@ -0,0 +1,119 @@
+package sslfsr
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ANF is a Boolean polynomial in algebraic normal form: the XOR of its terms, each the AND of the
+// register bits set in it. The term 0 is the constant 1.
+type ANF []uint64
+
+// NewANF constructs the ANF of the given terms, a term appearing twice cancels out
+func NewANF(terms ...uint64) (anf ANF) {
+	anf = ANF{}
+	for _, term := range terms {
+		if i := slices.Index(anf, term); i >= 0 {
+			anf = slices.Delete(anf, i, i+1)
+		} else {
+			anf = append(anf, term)
+		}
+	}
+
+	slices.Sort(anf)
+
+	return anf
+}
+
+// LinearANF is the ANF of a standard LFSR's feedback, the parity of the tapped bits
+func LinearANF(taps uint64) (anf ANF) {
+	anf = ANF{}
+	for taps != 0 {
+		bit := taps & -taps
+		anf = append(anf, bit)
+		taps &^= bit
+	}
+
+	return anf
+}
+
+// ParseANF reads an ANF written as terms joined by +, each 1 or bits like x3 joined by *, e.g.
+// "x0 + x2*x3 + 1". Bits are numbered from the least significant, the one a shift drops.
+func ParseANF(text string) (anf ANF, err error) {
+	terms := []uint64{}
+
+	for _, field := range strings.Split(text, "+") {
+		field = strings.TrimSpace(field)
+		if field == "1" {
+			terms = append(terms, 0)
+			continue
+		}
+
+		term := uint64(0)
+		for _, factor := range strings.Split(field, "*") {
+			factor = strings.TrimSpace(factor)
+			bit, err := strconv.Atoi(strings.TrimPrefix(factor, "x"))
+			if !strings.HasPrefix(factor, "x") || err != nil || bit < 0 || bit >= 64 {
+				return nil, fmt.Errorf("invalid factor %q in term %q", factor, field)
+			}
+
+			term |= 1 << bit
+		}
+
+		terms = append(terms, term)
+	}
+
+	return NewANF(terms...), nil
+}
+
+// String writes the ANF the way ParseANF reads it, 0 for no terms
+func (anf ANF) String() string {
+	if len(anf) == 0 {
+		return "0"
+	}
+
+	terms := make([]string, len(anf))
+	for i, term := range anf {
+		if term == 0 {
+			terms[i] = "1"
+			continue
+		}
+
+		factors := []string{}
+		for term != 0 {
+			factors = append(factors, "x"+strconv.Itoa(bits.TrailingZeros64(term)))
+			term &= term - 1
+		}
+		terms[i] = strings.Join(factors, "*")
+	}
+
+	return strings.Join(terms, " + ")
+}
+
+// Degree returns the most bits any term multiplies, 1 for a linear feedback
+func (anf ANF) Degree() (degree int) {
+	for _, term := range anf {
+		degree = max(degree, bits.OnesCount64(term))
+	}
+
+	return degree
+}
+
+// Eval returns the polynomial's value, 0 or 1, for the bits of register
+func (anf ANF) Eval(register uint64) (bit uint64) {
+	for _, term := range anf {
+		if register&term == term {
+			bit ^= 1
+		}
+	}
+
+	return bit
+}
+
+// Feedback returns Eval as a Feedback
+func (anf ANF) Feedback() Feedback {
+	return anf.Eval
+}
@@ -0,0 +1,53 @@
+package sslfsr
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseANF(t *testing.T) {
+	t.Parallel()
+
+	anf, err := ParseANF("x0 + x3*x2 + 1 + x5")
+	require.NoError(t, err)
+	assert.Equal(t, ANF{0, 0b1, 0b1100, 0b100000}, anf)
+	assert.Equal(t, "1 + x0 + x2*x3 + x5", anf.String())
+	assert.Equal(t, 2, anf.Degree())
+
+	again, err := ParseANF(anf.String())
+	require.NoError(t, err)
+	assert.Equal(t, anf, again)
+
+	// a repeated term cancels out
+	anf, err = ParseANF("x1 + x2 + x1")
+	require.NoError(t, err)
+	assert.Equal(t, ANF{0b100}, anf)
+	assert.Equal(t, "0", NewANF(1, 1).String())
+
+	for _, text := range []string{"", "x", "y1", "x1*", "x64", "2"} {
+		_, err = ParseANF(text)
+		assert.Error(t, err, text)
+	}
+}
+
+func TestANFEval(t *testing.T) {
+	t.Parallel()
+
+	anf, err := ParseANF("x0 + x1*x2 + 1")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), anf.Eval(0b000))
+	assert.Equal(t, uint64(0), anf.Eval(0b001))
+	assert.Equal(t, uint64(0), anf.Eval(0b011))
+	assert.Equal(t, uint64(0), anf.Eval(0b110))
+	assert.Equal(t, uint64(1), anf.Eval(0b111))
+
+	// a linear ANF is the parity of the taps
+	linear := LinearANF(Taps16Bits)
+	assert.Equal(t, 1, linear.Degree())
+	for register := range uint64(1 << 16) {
+		require.Equal(t, uint64(bits.OnesCount64(register&Taps16Bits)%2), linear.Eval(register))
+	}
+}
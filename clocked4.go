@@ -0,0 +1,70 @@
+package sslfsr
+
+// ClockedSSLFSR4 holds a 4 bit register clocked by its own contents: instead of counting an interval,
+// Next SubShifts whenever its trigger picks out the register and Shifts otherwise
+type ClockedSSLFSR4 struct {
+	register uint8
+	trigger  func(register uint8) bool
+}
+
+// NewClockedSSLFSR4 constructs a ClockedSSLFSR4 with a given trigger
+func NewClockedSSLFSR4(trigger func(register uint8) bool) (sslfsr ClockedSSLFSR4) {
+	return BuildClockedSSLFSR4(1, trigger)
+}
+
+// BuildClockedSSLFSR4 constructs a ClockedSSLFSR4 with a given register and trigger
+func BuildClockedSSLFSR4(register uint8, trigger func(register uint8) bool) (sslfsr ClockedSSLFSR4) {
+	return ClockedSSLFSR4{
+		register: register,
+		trigger:  trigger,
+	}
+}
+
+// GetRegister returns the current register value
+func (sslfsr *ClockedSSLFSR4) GetRegister() uint8 {
+	return sslfsr.register
+}
+
+// Next SubShifts when the trigger picks out the register, and Shifts otherwise
+func (sslfsr *ClockedSSLFSR4) Next() {
+	sslfsr.register = clocked4Bits(sslfsr.register, sslfsr.trigger)
+}
+
+// Shift modifies register by applying a standard LFSR shift to it, see Shift4Bits
+func (sslfsr *ClockedSSLFSR4) Shift() {
+	sslfsr.register = Shift4Bits(sslfsr.register)
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just it's lower bits, see SubShift4Bits
+func (sslfsr *ClockedSSLFSR4) SubShift() {
+	sslfsr.register = SubShift4Bits(sslfsr.register)
+}
+
+// MaskTrigger4Bits picks out the registers whose bits under mask equal value
+func MaskTrigger4Bits(mask uint8, value uint8) func(register uint8) bool {
+	return func(register uint8) bool {
+		return register&mask == value
+	}
+}
+
+// ControlBitTrigger4Bits picks out the registers with bit set, the way a control bit decides whether
+// an A5/1 register is clocked
+func ControlBitTrigger4Bits(bit int) func(register uint8) bool {
+	return MaskTrigger4Bits(1<<bit, 1<<bit)
+}
+
+// AnalyzeClocking4Bits measures the period and cycle structure of a ClockedSSLFSR4 with trigger by
+// following Next from every register
+func AnalyzeClocking4Bits(trigger func(register uint8) bool) (analysis ClockingAnalysis) {
+	return analyzeClocking(4, func(register uint64) uint64 {
+		return uint64(clocked4Bits(uint8(register), trigger))
+	})
+}
+
+func clocked4Bits(register uint8, trigger func(register uint8) bool) uint8 {
+	if trigger(register) {
+		return SubShift4Bits(register)
+	}
+
+	return Shift4Bits(register)
+}
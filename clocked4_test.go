@@ -0,0 +1,64 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClockedSSLFSR4(t *testing.T) {
+	t.Parallel()
+
+	never := NewClockedSSLFSR4(func(uint8) bool { return false })
+	always := NewClockedSSLFSR4(func(uint8) bool { return true })
+	register := uint8(1)
+	subRegister := uint8(1)
+
+	for range 100 {
+		never.Next()
+		always.Next()
+		register = Shift4Bits(register)
+		subRegister = SubShift4Bits(subRegister)
+
+		assert.Equal(t, register, never.GetRegister())
+		assert.Equal(t, subRegister, always.GetRegister())
+	}
+}
+
+func TestAnalyzeClocking4Bits(t *testing.T) {
+	t.Parallel()
+
+	triggers := []func(uint8) bool{ControlBitTrigger4Bits(0), ControlBitTrigger4Bits(3), MaskTrigger4Bits(0b11, 0b10)}
+	for i, trigger := range triggers {
+		analysis := AnalyzeClocking4Bits(trigger)
+
+		// walk from register 1 until a register comes around again
+		reg := NewClockedSSLFSR4(trigger)
+		seen := map[uint8]int{}
+		for step := 0; ; step++ {
+			if first, ok := seen[reg.GetRegister()]; ok {
+				assert.Equal(t, first, analysis.Tail, "trigger %d", i)
+				assert.Equal(t, step-first, analysis.Period, "trigger %d", i)
+				break
+			}
+
+			seen[reg.GetRegister()] = step
+			reg.Next()
+		}
+
+		total := 0
+		for _, cycle := range analysis.Cycles {
+			total += cycle
+		}
+		assert.Equal(t, analysis.Permutation, total == 15+1, "every register is on a cycle only for permutations, trigger %d", i)
+	}
+}
+
+func TestTriggers4Bits(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ControlBitTrigger4Bits(1)(0b0010))
+	assert.False(t, ControlBitTrigger4Bits(1)(0b0101))
+	assert.True(t, MaskTrigger4Bits(0b0110, 0b0100)(0b1101))
+	assert.False(t, MaskTrigger4Bits(0b0110, 0b0100)(0b1111))
+}
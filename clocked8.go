@@ -0,0 +1,70 @@
+package sslfsr
+
+// ClockedSSLFSR8 holds a 8 bit register clocked by its own contents: instead of counting an interval,
+// Next SubShifts whenever its trigger picks out the register and Shifts otherwise
+type ClockedSSLFSR8 struct {
+	register uint8
+	trigger  func(register uint8) bool
+}
+
+// NewClockedSSLFSR8 constructs a ClockedSSLFSR8 with a given trigger
+func NewClockedSSLFSR8(trigger func(register uint8) bool) (sslfsr ClockedSSLFSR8) {
+	return BuildClockedSSLFSR8(1, trigger)
+}
+
+// BuildClockedSSLFSR8 constructs a ClockedSSLFSR8 with a given register and trigger
+func BuildClockedSSLFSR8(register uint8, trigger func(register uint8) bool) (sslfsr ClockedSSLFSR8) {
+	return ClockedSSLFSR8{
+		register: register,
+		trigger:  trigger,
+	}
+}
+
+// GetRegister returns the current register value
+func (sslfsr *ClockedSSLFSR8) GetRegister() uint8 {
+	return sslfsr.register
+}
+
+// Next SubShifts when the trigger picks out the register, and Shifts otherwise
+func (sslfsr *ClockedSSLFSR8) Next() {
+	sslfsr.register = clocked8Bits(sslfsr.register, sslfsr.trigger)
+}
+
+// Shift modifies register by applying a standard LFSR shift to it, see Shift8Bits
+func (sslfsr *ClockedSSLFSR8) Shift() {
+	sslfsr.register = Shift8Bits(sslfsr.register)
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just it's lower bits, see SubShift8Bits
+func (sslfsr *ClockedSSLFSR8) SubShift() {
+	sslfsr.register = SubShift8Bits(sslfsr.register)
+}
+
+// MaskTrigger8Bits picks out the registers whose bits under mask equal value
+func MaskTrigger8Bits(mask uint8, value uint8) func(register uint8) bool {
+	return func(register uint8) bool {
+		return register&mask == value
+	}
+}
+
+// ControlBitTrigger8Bits picks out the registers with bit set, the way a control bit decides whether
+// an A5/1 register is clocked
+func ControlBitTrigger8Bits(bit int) func(register uint8) bool {
+	return MaskTrigger8Bits(1<<bit, 1<<bit)
+}
+
+// AnalyzeClocking8Bits measures the period and cycle structure of a ClockedSSLFSR8 with trigger by
+// following Next from every register
+func AnalyzeClocking8Bits(trigger func(register uint8) bool) (analysis ClockingAnalysis) {
+	return analyzeClocking(8, func(register uint64) uint64 {
+		return uint64(clocked8Bits(uint8(register), trigger))
+	})
+}
+
+func clocked8Bits(register uint8, trigger func(register uint8) bool) uint8 {
+	if trigger(register) {
+		return SubShift8Bits(register)
+	}
+
+	return Shift8Bits(register)
+}
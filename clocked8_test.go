@@ -0,0 +1,64 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClockedSSLFSR8(t *testing.T) {
+	t.Parallel()
+
+	never := NewClockedSSLFSR8(func(uint8) bool { return false })
+	always := NewClockedSSLFSR8(func(uint8) bool { return true })
+	register := uint8(1)
+	subRegister := uint8(1)
+
+	for range 100 {
+		never.Next()
+		always.Next()
+		register = Shift8Bits(register)
+		subRegister = SubShift8Bits(subRegister)
+
+		assert.Equal(t, register, never.GetRegister())
+		assert.Equal(t, subRegister, always.GetRegister())
+	}
+}
+
+func TestAnalyzeClocking8Bits(t *testing.T) {
+	t.Parallel()
+
+	triggers := []func(uint8) bool{ControlBitTrigger8Bits(0), ControlBitTrigger8Bits(7), MaskTrigger8Bits(0b11, 0b10)}
+	for i, trigger := range triggers {
+		analysis := AnalyzeClocking8Bits(trigger)
+
+		// walk from register 1 until a register comes around again
+		reg := NewClockedSSLFSR8(trigger)
+		seen := map[uint8]int{}
+		for step := 0; ; step++ {
+			if first, ok := seen[reg.GetRegister()]; ok {
+				assert.Equal(t, first, analysis.Tail, "trigger %d", i)
+				assert.Equal(t, step-first, analysis.Period, "trigger %d", i)
+				break
+			}
+
+			seen[reg.GetRegister()] = step
+			reg.Next()
+		}
+
+		total := 0
+		for _, cycle := range analysis.Cycles {
+			total += cycle
+		}
+		assert.Equal(t, analysis.Permutation, total == 255+1, "every register is on a cycle only for permutations, trigger %d", i)
+	}
+}
+
+func TestTriggers8Bits(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ControlBitTrigger8Bits(1)(0b0010))
+	assert.False(t, ControlBitTrigger8Bits(1)(0b0101))
+	assert.True(t, MaskTrigger8Bits(0b0110, 0b0100)(0b1101))
+	assert.False(t, MaskTrigger8Bits(0b0110, 0b0100)(0b1111))
+}
@@ -0,0 +1,77 @@
+package sslfsr
+
+import "sort"
+
+// ClockingAnalysis is the cycle structure of a register clocked by its own contents, see
+// ClockedSSLFSR8. Choosing between Shift and SubShift by register can send two registers to the same
+// one, so unlike SSLFSR8 a register may run into a cycle it isn't part of.
+type ClockingAnalysis struct {
+	Width       int   `json:"width"`
+	Permutation bool  `json:"permutation"` // no two registers lead to the same one, so every register is on a cycle
+	Cycles      []int `json:"cycles"`      // length of every cycle, longest first
+	Tail        int   `json:"tail"`        // Next() calls from register 1 until it's on a cycle
+	Period      int   `json:"period"`      // length of the cycle register 1 ends up on
+}
+
+// Maximal reports whether register 1 is on a cycle through every non-zero register, 0 never changes
+func (analysis ClockingAnalysis) Maximal() bool {
+	return analysis.Tail == 0 && analysis.Period == 1<<analysis.Width-1
+}
+
+// analyzeClocking follows next from every width bit register
+func analyzeClocking(width int, next func(register uint64) uint64) (analysis ClockingAnalysis) {
+	states := uint64(1) << width
+	analysis = ClockingAnalysis{Width: width, Permutation: true, Cycles: []int{}}
+
+	// registers reached from somewhere else, a second arrival means next isn't a permutation
+	reached := make([]bool, states)
+	for register := range states {
+		if reached[next(register)] {
+			analysis.Permutation = false
+		}
+		reached[next(register)] = true
+	}
+
+	// walk from every register not yet walked, a walk ends on a new cycle or one already found
+	const unvisited = -1
+	walk := make([]int, states) // walk a register was first reached on
+	step := make([]int, states) // steps into that walk it was reached
+	for i := range walk {
+		walk[i] = unvisited
+	}
+
+	for start := range states {
+		register := start
+		steps := 0
+		for walk[register] == unvisited {
+			walk[register] = int(start)
+			step[register] = steps
+			register = next(register)
+			steps++
+		}
+
+		if walk[register] == int(start) {
+			analysis.Cycles = append(analysis.Cycles, steps-step[register])
+		}
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(analysis.Cycles)))
+
+	// walk from register 1 again on its own, it may have run into an earlier walk
+	for i := range walk {
+		walk[i] = unvisited
+	}
+
+	register := uint64(1)
+	steps := 0
+	for walk[register] == unvisited {
+		walk[register] = steps
+		register = next(register)
+		steps++
+	}
+
+	analysis.Tail = walk[register]
+	analysis.Period = steps - walk[register]
+
+	return analysis
+}
@@ -0,0 +1,38 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeClocking(t *testing.T) {
+	t.Parallel()
+
+	// 1 runs into the cycle 2 -> 3 -> 2, as does 7 by way of 1
+	next := []uint64{0, 2, 3, 2, 5, 4, 6, 1}
+	analysis := analyzeClocking(3, func(register uint64) uint64 { return next[register] })
+
+	assert.False(t, analysis.Permutation)
+	assert.Equal(t, []int{2, 2, 1, 1}, analysis.Cycles)
+	assert.Equal(t, 1, analysis.Tail)
+	assert.Equal(t, 2, analysis.Period)
+	assert.False(t, analysis.Maximal())
+}
+
+func TestAnalyzeClockingShiftOnly(t *testing.T) {
+	t.Parallel()
+
+	analysis := AnalyzeClocking8Bits(func(uint8) bool { return false })
+
+	assert.True(t, analysis.Permutation)
+	assert.Equal(t, []int{255, 1}, analysis.Cycles)
+	assert.Zero(t, analysis.Tail)
+	assert.Equal(t, 255, analysis.Period)
+	assert.True(t, analysis.Maximal())
+
+	analysis = AnalyzeClocking4Bits(func(uint8) bool { return true })
+	assert.True(t, analysis.Permutation)
+	assert.Equal(t, 3, analysis.Period, "SubShift4Bits only cycles the lower 2 bits")
+	assert.False(t, analysis.Maximal())
+}
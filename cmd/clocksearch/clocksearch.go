@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/coreyog/sslfsr/internal/solver"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() (code int) {
+	width := flag.Int("width", 8, "register width in bits, 4 or 8")
+	format := flag.String("format", solver.FormatText, "results format: "+strings.Join(solver.ClockingFormats, "|"))
+	output := flag.String("output", "-", "results file, - for stdout only")
+	top := flag.Int("top", 20, "triggers listed by the text format")
+	flag.Parse()
+
+	switch {
+	case !slices.Contains(solver.ClockingWidths, *width):
+		return solver.Usage("width must be 4 or 8")
+	case !slices.Contains(solver.ClockingFormats, *format):
+		return solver.Usage("unknown format %q", *format)
+	}
+
+	results, err := solver.SearchClocking(*width)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return solver.ExitFailure
+	}
+
+	report := solver.NewClockingReport(*width, results)
+
+	return solver.FinishSearch(report, false, *output, *format, *top)
+}
@@ -0,0 +1,131 @@
+// Command genintervals writes the Go source of Intervals<width>Bits, the known optimum intervals of
+// the library. The intervals come from solver results files when any are given, which must cover
+// every interval and have each optimal interval's full period proven, otherwise every interval's
+// period is proven directly. go generate in the repository root regenerates every table from the
+// solver results committed in testdata.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/coreyog/sslfsr/internal/intervalgen"
+	"github.com/coreyog/sslfsr/internal/solver"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() (code int) {
+	width := flag.Int("width", 0, "register width of the table")
+	output := flag.String("output", "", "Go source file, - for stdout (default intervals<width>.go)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s --width n [flags] [results.ndjson|results.json|results.csv...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	spec, ok := solver.SpecFor(*width)
+	if !ok {
+		flag.Usage()
+		return solver.ExitUsage
+	}
+
+	if *output == "" {
+		*output = fmt.Sprintf("intervals%d.go", *width)
+	}
+
+	src, err := generate(spec, flag.Args(), command(os.Args[1:]))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return solver.ExitFailure
+	}
+
+	if *output == "-" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(*output, src, 0o644)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to write %s: %s\n", *output, err)
+		return solver.ExitFailure
+	}
+
+	return solver.ExitOK
+}
+
+// command is the command line recorded in the generated code header, without --output so the
+// header doesn't depend on where the file was written
+func command(args []string) string {
+	parts := []string{"genintervals"}
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--output" || args[i] == "-output":
+			i++
+		case strings.HasPrefix(args[i], "--output=") || strings.HasPrefix(args[i], "-output="):
+		default:
+			parts = append(parts, args[i])
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// generate returns the source of spec's Intervals<width>Bits from the named results files, or proven
+// directly when there are none
+func generate(spec solver.Spec, names []string, command string) (src []byte, err error) {
+	intervals := []int{}
+
+	if len(names) == 0 {
+		intervals = solver.ProvenIntervals(spec)
+	} else {
+		intervals, err = fromResults(spec, names)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return intervalgen.Source(spec.Width, intervals, command)
+}
+
+// fromResults merges the named results files and returns their verified optimal intervals
+func fromResults(spec solver.Spec, names []string) (intervals []int, err error) {
+	reports := []solver.Report{}
+	var elapsed time.Duration
+
+	for _, name := range names {
+		report, err := solver.ReadReportFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		reports = append(reports, report)
+		elapsed += report.Summary.Elapsed
+	}
+
+	last := spec.MaxRegister() - 1
+
+	results, coverage, err := solver.Merge(reports, 1, last)
+	if err != nil {
+		return nil, err
+	}
+
+	if !coverage.Complete() {
+		return nil, fmt.Errorf("the results don't cover every interval of [1, %d] without conflicts", last)
+	}
+
+	if results[0].Width != spec.Width {
+		return nil, fmt.Errorf("the results are for %d bit registers, not %d", results[0].Width, spec.Width)
+	}
+
+	if results[0].Taps != spec.Taps || results[0].SubTaps != spec.SubTaps {
+		return nil, fmt.Errorf("the results are for taps %#x and %#x, not %#x and %#x", results[0].Taps, results[0].SubTaps, spec.Taps, spec.SubTaps)
+	}
+
+	return solver.VerifiedIntervals(spec, solver.NewReport(spec, 1, last, results, elapsed).Summary)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/coreyog/sslfsr/internal/solver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCommittedTables fails when a committed Intervals<width>Bits table differs from what go generate
+// would write from the committed solver results, e.g. after a hand edit, or when those results don't
+// agree with proving every interval directly
+func TestCommittedTables(t *testing.T) {
+	t.Parallel()
+
+	for width := 2; width <= sslfsr.MaxWidth; width++ {
+		if _, ok := sslfsr.KnownIntervals(width); !ok {
+			continue
+		}
+
+		t.Run(fmt.Sprintf("%d bits", width), func(t *testing.T) {
+			t.Parallel()
+
+			spec, ok := solver.SpecFor(width)
+			require.True(t, ok)
+
+			committed, err := os.ReadFile(fmt.Sprintf("../../intervals%d.go", width))
+			require.NoError(t, err)
+
+			results := fmt.Sprintf("testdata/results%d.csv", width)
+			src, err := generate(spec, []string{"../../" + results}, fmt.Sprintf("genintervals --width %d %s", width, results))
+			require.NoError(t, err)
+
+			assert.Equal(t, string(src), string(committed), "run go generate in the repository root")
+
+			intervals, _ := sslfsr.KnownIntervals(width)
+			assert.Equal(t, solver.ProvenIntervals(spec), intervals)
+		})
+	}
+}
+
+func TestGenerateFromResults(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	full := filepath.Join(dir, "results8.ndjson")
+	partial := filepath.Join(dir, "partial8.ndjson")
+
+	optimal := solver.ProvenIntervals(solver.Spec8Bits)
+	results := []solver.Result{}
+	for interval := 1; interval <= 254; interval++ {
+		results = append(results, solver.Result{Width: 8, Taps: solver.Spec8Bits.Taps, SubTaps: solver.Spec8Bits.SubTaps, Interval: interval, Optimal: slices.Contains(optimal, interval)})
+	}
+
+	require.NoError(t, solver.NewReport(solver.Spec8Bits, 1, 254, results, 0).WriteFile(full, solver.FormatNDJSON))
+	require.NoError(t, solver.NewReport(solver.Spec8Bits, 1, 100, results[:100], 0).WriteFile(partial, solver.FormatNDJSON))
+
+	fromResults, err := generate(solver.Spec8Bits, []string{full}, "genintervals --width 8")
+	require.NoError(t, err)
+	proven, err := generate(solver.Spec8Bits, nil, "genintervals --width 8")
+	require.NoError(t, err)
+	assert.Equal(t, string(proven), string(fromResults))
+
+	_, err = generate(solver.Spec8Bits, []string{partial}, "genintervals --width 8")
+	assert.Error(t, err, "results missing intervals can't be trusted")
+
+	_, err = generate(solver.Spec4Bits, []string{full}, "genintervals --width 4")
+	assert.Error(t, err, "8 bit results aren't 4 bit results")
+
+	retapped := solver.Spec8Bits
+	retapped.SubTaps++
+	_, err = generate(retapped, []string{full}, "genintervals --width 8")
+	assert.Error(t, err, "results of other taps aren't these taps' results")
+}
+
+func TestCommand(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "genintervals --width 16", command([]string{"--width", "16"}))
+	assert.Equal(t, "genintervals --width 16 a.ndjson", command([]string{"--output", "x.go", "--width", "16", "--output=y.go", "a.ndjson"}))
+}
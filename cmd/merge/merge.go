@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/coreyog/sslfsr/internal/solver"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() (code int) {
+	format := flag.String("format", solver.FormatText, "merged results format: "+strings.Join(solver.Formats, "|"))
+	output := flag.String("output", "-", "merged results file, - for stdout only")
+	start := flag.Int("start", 0, "first interval the results should cover (default the solver's default)")
+	end := flag.Int("end", 0, "last interval the results should cover (default the solver's default)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] results.ndjson|results.json|results.csv...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if !slices.Contains(solver.Formats, *format) || flag.NArg() == 0 {
+		flag.Usage()
+		return solver.ExitUsage
+	}
+
+	reports := []solver.Report{}
+	var elapsed time.Duration
+
+	for _, name := range flag.Args() {
+		report, err := solver.ReadReportFile(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", name, err)
+			return solver.ExitFailure
+		}
+
+		reports = append(reports, report)
+		elapsed += report.Summary.Elapsed
+	}
+
+	// an empty or interrupted shard may have no summary, the first result has the width
+	width := 0
+	for _, report := range reports {
+		if len(report.Results) > 0 {
+			width = report.Results[0].Width
+			break
+		}
+	}
+
+	if width == 0 {
+		fmt.Fprintln(os.Stderr, "no results to merge")
+		return solver.ExitFailure
+	}
+
+	spec, ok := solver.SpecFor(width)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "no solver for %d bit registers\n", width)
+		return solver.ExitFailure
+	}
+
+	if *start == 0 {
+		*start = spec.DefaultRange().Start
+	}
+
+	if *end == 0 {
+		*end = spec.DefaultRange().End
+	}
+
+	results, coverage, err := solver.Merge(reports, *start, *end)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return solver.ExitFailure
+	}
+
+	merged := solver.NewReport(spec, *start, *end, results, elapsed)
+
+	// the human readable summary is always shown
+	_ = merged.Summary.WriteText(os.Stdout)
+	printRanges("gaps", coverage.Gaps)
+	printRanges("overlaps", coverage.Overlaps)
+	if len(coverage.Conflicts) > 0 {
+		fmt.Printf("conflicting results: %v\n", coverage.Conflicts)
+	}
+
+	err = merged.WriteFile(*output, *format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to write %s: %s\n", *output, err)
+		return solver.ExitFailure
+	}
+
+	if !coverage.Complete() || !merged.Summary.Matches {
+		return solver.ExitMismatch
+	}
+
+	return solver.ExitOK
+}
+
+func printRanges(name string, ranges []solver.Range) {
+	if len(ranges) == 0 {
+		return
+	}
+
+	parts := make([]string, len(ranges))
+	for i, r := range ranges {
+		parts[i] = fmt.Sprintf("[%d, %d]", r.Start, r.End)
+	}
+
+	fmt.Printf("%s: %s\n", name, strings.Join(parts, " "))
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/coreyog/sslfsr/internal/solver"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() (code int) {
+	width := flag.Int("width", 8, "register width in bits")
+	levels := flag.Int("levels", 2, "nested sub-registers, each half the width of the one above")
+	maxInterval := flag.Uint64("max-interval", 0, "largest interval searched at every level, 0 for 2^width-2")
+	format := flag.String("format", solver.FormatText, "results format: "+strings.Join(solver.NestedFormats, "|"))
+	output := flag.String("output", "-", "results file, - for stdout only")
+	workers := flag.Int("workers", runtime.NumCPU(), "number of first level intervals to search concurrently")
+	top := flag.Int("top", 20, "configurations listed by the text format")
+	every := flag.Duration("progress-interval", 10*time.Second, "how often progress is written")
+	flag.Parse()
+
+	space := solver.NestedSpace{Width: *width, Levels: *levels, MaxInterval: *maxInterval}
+	if space.MaxInterval == 0 && *width > 1 && *width < 64 {
+		space.MaxInterval = 1<<*width - 2
+	}
+
+	err := space.Validate()
+
+	switch {
+	case err != nil:
+		return solver.Usage("%s", err)
+	case !slices.Contains(solver.NestedFormats, *format):
+		return solver.Usage("unknown format %q", *format)
+	}
+
+	if err := solver.CheckSearch(*workers, *every); err != nil {
+		return solver.Usage("%s", err)
+	}
+
+	// prepare for interruptions, a second signal kills the process outright
+	ctx, stop := solver.NotifyContext()
+	defer stop()
+
+	progress := solver.LogProgress(*every, "of", space.Configurations())
+	tested := 0
+
+	results, err := solver.SearchNested(ctx, space, *workers, func(total int) {
+		tested = total
+		progress(tested)
+	})
+
+	report := solver.NewNestedReport(space, tested, results)
+	report.Interrupted = err != nil
+
+	return solver.FinishSearch(report, report.Interrupted, *output, *format, *top)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/coreyog/sslfsr/combiner"
+	"github.com/coreyog/sslfsr/internal/solver"
+)
+
+var formats = []string{solver.FormatText, solver.FormatJSON}
+
+func main() {
+	os.Exit(run())
+}
+
+func run() (code int) {
+	atLeast := flag.String("at-least", "", "smallest acceptable period, any size")
+	multipleOf := flag.Uint64("multiple-of", 0, "the period must be a multiple of this")
+	coprime := flag.Bool("coprime", false, "only use registers with pairwise coprime periods")
+	maxRegisters := flag.Int("max-registers", combiner.DefaultMaxRegisters, "most registers to combine")
+	widths := flag.String("widths", "", "comma separated register widths to choose from, every width with known intervals by default")
+	format := flag.String("format", solver.FormatText, "plan format: "+strings.Join(formats, "|"))
+	flag.Parse()
+
+	target := combiner.Target{MultipleOf: *multipleOf, Coprime: *coprime, MaxRegisters: *maxRegisters}
+
+	if *atLeast != "" {
+		n, ok := new(big.Int).SetString(*atLeast, 10)
+		if !ok || n.Sign() < 1 {
+			return solver.Usage("at-least must be a positive whole number, not %q", *atLeast)
+		}
+
+		target.AtLeast = n
+	}
+
+	if *widths != "" {
+		for _, field := range strings.Split(*widths, ",") {
+			width, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil {
+				return solver.Usage("invalid width %q", field)
+			}
+
+			target.Widths = append(target.Widths, width)
+		}
+	}
+
+	switch {
+	case target.AtLeast == nil && target.MultipleOf < 2:
+		return solver.Usage("at-least or multiple-of is needed")
+	case *maxRegisters < 1:
+		return solver.Usage("max-registers must be at least 1")
+	case !slices.Contains(formats, *format):
+		return solver.Usage("unknown format %q", *format)
+	}
+
+	plan, err := combiner.NewPlan(target)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to plan: %s\n", err)
+		return solver.ExitFailure
+	}
+
+	err = plan.Verify()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "plan failed verification: %s\n", err)
+		return solver.ExitFailure
+	}
+
+	if *format == solver.FormatJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(plan)
+
+		return solver.ExitOK
+	}
+
+	_ = plan.WriteText(os.Stdout)
+	fmt.Println("verified")
+
+	return solver.ExitOK
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/coreyog/sslfsr/internal/solver"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() (code int) {
+	width := flag.Int("width", 8, "register width in bits, 2 through 10")
+	length := flag.Int("length", 2, "intervals per schedule, 1 through 3")
+	format := flag.String("format", solver.FormatText, "results format: "+strings.Join(solver.ScheduleFormats, "|"))
+	output := flag.String("output", "-", "results file, - for stdout only")
+	workers := flag.Int("workers", runtime.NumCPU(), "number of first intervals to search concurrently")
+	top := flag.Int("top", 20, "schedules listed by the text format")
+	every := flag.Duration("progress-interval", 10*time.Second, "how often progress is written")
+	flag.Parse()
+
+	spec, ok := solver.SpecFor(*width)
+
+	switch {
+	case !ok || *width > 10:
+		return solver.Usage("width must be between 2 and 10")
+	case *length < 1 || *length > 3:
+		return solver.Usage("length must be between 1 and 3")
+	case !slices.Contains(solver.ScheduleFormats, *format):
+		return solver.Usage("unknown format %q", *format)
+	}
+
+	if err := solver.CheckSearch(*workers, *every); err != nil {
+		return solver.Usage("%s", err)
+	}
+
+	// prepare for interruptions, a second signal kills the process outright
+	ctx, stop := solver.NotifyContext()
+	defer stop()
+
+	progress := solver.LogProgress(*every)
+	tested := 0
+
+	results, err := solver.SearchSchedules(ctx, spec, *length, *workers, func(total int) {
+		tested = total
+		progress(tested)
+	})
+
+	report := solver.NewScheduleReport(spec, *length, tested, results)
+	report.Interrupted = err != nil
+
+	return solver.FinishSearch(report, report.Interrupted, *output, *format, *top)
+}
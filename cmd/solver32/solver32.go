@@ -0,0 +1,9 @@
+package main
+
+import "github.com/coreyog/sslfsr/internal/solver"
+
+//go:generate go build "-gcflags=all=-N -l" .
+
+func main() {
+	solver.Main(solver.Spec32Bits)
+}
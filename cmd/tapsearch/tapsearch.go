@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/coreyog/sslfsr/internal/solver"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() (code int) {
+	width := flag.Int("width", 8, "register width in bits, the sub register is the lower half")
+	format := flag.String("format", solver.FormatText, "results format: "+strings.Join(solver.TapFormats, "|"))
+	output := flag.String("output", "-", "results file, - for stdout only")
+	workers := flag.Int("workers", runtime.NumCPU(), "number of tap pairs to search concurrently")
+	top := flag.Int("top", 20, "tap pairs listed by the text format")
+	every := flag.Duration("progress-interval", 10*time.Second, "how often progress is written")
+	flag.Parse()
+
+	switch {
+	case *width < 2 || *width > 16:
+		return solver.Usage("width must be between 2 and 16")
+	case !slices.Contains(solver.TapFormats, *format):
+		return solver.Usage("unknown format %q", *format)
+	}
+
+	if err := solver.CheckSearch(*workers, *every); err != nil {
+		return solver.Usage("%s", err)
+	}
+
+	// prepare for interruptions, a second signal kills the process outright
+	ctx, stop := solver.NotifyContext()
+	defer stop()
+
+	progress := solver.LogProgress(*every)
+	tested := 0
+
+	results, err := solver.SearchTaps(ctx, *width, *workers, func(solver.TapResult) {
+		tested++
+		progress(tested)
+	})
+
+	report := solver.NewTapReport(*width, results)
+	report.Interrupted = err != nil
+
+	return solver.FinishSearch(report, report.Interrupted, *output, *format, *top)
+}
@@ -0,0 +1,56 @@
+package combiner
+
+import "math/big"
+
+// AlternatingStep steps its control register every time, then steps first when the control's bit is
+// 1 and second otherwise, and outputs the XOR of first's and second's bits
+type AlternatingStep struct {
+	control *Register
+	first   *Register
+	second  *Register
+}
+
+// NewAlternatingStep constructs an alternating step generator whose control picks which of first and
+// second steps
+func NewAlternatingStep(control *Register, first *Register, second *Register) (gen *AlternatingStep) {
+	return &AlternatingStep{control: control, first: first, second: second}
+}
+
+// NextBit steps the control and one of the other registers and returns the XOR of their bits
+func (gen *AlternatingStep) NextBit() (bit uint8) {
+	gen.control.Next()
+
+	if gen.control.Bit() == 1 {
+		gen.first.Next()
+	} else {
+		gen.second.Next()
+	}
+
+	return gen.first.Bit() ^ gen.second.Bit()
+}
+
+// Period returns how many bits come out before every register starts over together. Each of the
+// control's periods steps first its Ones times and second the rest, so it takes the least number of
+// control periods that bring both of them back around.
+func (gen *AlternatingStep) Period() *big.Int {
+	controlPeriod := new(big.Int).SetUint64(gen.control.Period())
+	ones := new(big.Int).SetUint64(gen.control.Ones())
+	zeros := new(big.Int).Sub(controlPeriod, ones)
+
+	// control periods for a register stepped steps times a control period to start over
+	periods := func(register *Register, steps *big.Int) uint64 {
+		period := new(big.Int).SetUint64(register.Period())
+		gcd := new(big.Int).GCD(nil, nil, period, steps)
+
+		return period.Div(period, gcd).Uint64()
+	}
+
+	multiple := lcm(periods(gen.first, ones), periods(gen.second, zeros))
+
+	return multiple.Mul(multiple, controlPeriod)
+}
+
+// Read fills p with the generator's bits, see Generator
+func (gen *AlternatingStep) Read(p []byte) (n int, err error) {
+	return read(gen, p)
+}
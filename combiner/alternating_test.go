@@ -0,0 +1,24 @@
+package combiner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlternatingStep(t *testing.T) {
+	t.Parallel()
+
+	control, first, second := must4Bits(t, 1), must4Bits(t, 7), must4Bits(t, 13)
+	gen := NewAlternatingStep(control, first, second)
+
+	for range 50 {
+		bit := gen.NextBit()
+		assert.Equal(t, first.Bit()^second.Bit(), bit)
+	}
+
+	// control steps first 16 times and second 14 times a period, 15 and 15 periods bring them back
+	gen = NewAlternatingStep(must4Bits(t, 1), must4Bits(t, 7), must4Bits(t, 13))
+	assert.Equal(t, int64(30*15), gen.Period().Int64())
+	assert.Equal(t, 30*15, minimalPeriod(gen, 3*30*15))
+}
@@ -0,0 +1,157 @@
+// Package combiner mixes the output of several SSLFSRs into one stronger sequence of bits. Each
+// generator steps its registers with their Next() and reads the lowest bit of the register after
+// each step, the bit the next Shift drops.
+package combiner
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/coreyog/sslfsr"
+)
+
+// Register is an SSLFSR with an optimal interval a generator can step and read bits from
+type Register struct {
+	width    int
+	interval uint64
+	next     func()
+	register func() uint64
+}
+
+// New4Bits constructs a Register from an SSLFSR4 with one of Intervals4Bits
+func New4Bits(interval uint8) (register *Register, err error) {
+	if !sslfsr.IsOptimalInterval4Bits(interval) {
+		return nil, fmt.Errorf("%d isn't one of Intervals4Bits", interval)
+	}
+
+	reg := sslfsr.NewSSLFSR4(interval)
+
+	return &Register{
+		width:    4,
+		interval: uint64(interval),
+		next:     reg.Next,
+		register: func() uint64 { return uint64(reg.GetRegister()) },
+	}, nil
+}
+
+// New8Bits constructs a Register from an SSLFSR8 with one of Intervals8Bits
+func New8Bits(interval uint8) (register *Register, err error) {
+	if !sslfsr.IsOptimalInterval8Bits(interval) {
+		return nil, fmt.Errorf("%d isn't one of Intervals8Bits", interval)
+	}
+
+	reg := sslfsr.NewSSLFSR8(interval)
+
+	return &Register{
+		width:    8,
+		interval: uint64(interval),
+		next:     reg.Next,
+		register: func() uint64 { return uint64(reg.GetRegister()) },
+	}, nil
+}
+
+// New16Bits constructs a Register from an SSLFSR16 with one of Intervals16Bits
+func New16Bits(interval uint16) (register *Register, err error) {
+	if !sslfsr.IsOptimalInterval16Bits(interval) {
+		return nil, fmt.Errorf("%d isn't one of Intervals16Bits", interval)
+	}
+
+	reg := sslfsr.NewSSLFSR16(interval)
+
+	return &Register{
+		width:    16,
+		interval: uint64(interval),
+		next:     reg.Next,
+		register: func() uint64 { return uint64(reg.GetRegister()) },
+	}, nil
+}
+
+// NewWidth constructs a Register from an SSLFSR of any width with one of its KnownIntervals
+func NewWidth(width int, interval uint64) (register *Register, err error) {
+	known, ok := sslfsr.KnownIntervals(width)
+	if !ok || !slices.Contains(known, int(interval)) {
+		return nil, fmt.Errorf("%d isn't a known interval for %d bits", interval, width)
+	}
+
+	reg, err := sslfsr.NewSSLFSR(width, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Register{
+		width:    width,
+		interval: interval,
+		next:     reg.Next,
+		register: reg.GetRegister,
+	}, nil
+}
+
+// Next steps the register once
+func (register *Register) Next() {
+	register.next()
+}
+
+// GetRegister returns the current register value
+func (register *Register) GetRegister() uint64 {
+	return register.register()
+}
+
+// Bit returns the lowest bit of the register
+func (register *Register) Bit() uint8 {
+	return uint8(register.register() & 1)
+}
+
+// ClockBit returns the lowest bit of the upper half of the register, the half a SubShift leaves alone,
+// for majority clocking
+func (register *Register) ClockBit() uint8 {
+	return uint8(register.register() >> (register.width / 2) & 1)
+}
+
+// Period returns how many steps the register takes to start over, (2^width-1)(interval+1)
+func (register *Register) Period() uint64 {
+	return (1<<register.width - 1) * (register.interval + 1)
+}
+
+// Ones returns how many of the bits in a period are 1. A pass through an optimal interval takes every
+// non-zero register to a different one at each step, so each step of a pass sees every non-zero
+// register once a period and half of them are odd.
+func (register *Register) Ones() uint64 {
+	return 1 << (register.width - 1) * (register.interval + 1)
+}
+
+// Generator produces a sequence of bits from its registers
+type Generator interface {
+	// NextBit steps the generator and returns its next bit
+	NextBit() uint8
+	// Period returns the period of the generator's state, which its bits repeat within. They usually
+	// repeat with exactly this period, but a generator can't tell when they happen to repeat sooner.
+	Period() *big.Int
+}
+
+// read packs the next len(p) * 8 bits of a generator into p, the first bit the most significant
+func read(gen Generator, p []byte) (n int, err error) {
+	for i := range p {
+		b := byte(0)
+		for range 8 {
+			b = b<<1 | gen.NextBit()
+		}
+		p[i] = b
+	}
+
+	return len(p), nil
+}
+
+// lcm returns the least common multiple of every period
+func lcm(periods ...uint64) (multiple *big.Int) {
+	multiple = big.NewInt(1)
+	gcd := &big.Int{}
+
+	for _, period := range periods {
+		p := new(big.Int).SetUint64(period)
+		gcd.GCD(nil, nil, multiple, p)
+		multiple.Mul(multiple, p.Div(p, gcd))
+	}
+
+	return multiple
+}
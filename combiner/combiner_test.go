@@ -0,0 +1,93 @@
+package combiner
+
+import (
+	"io"
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	_, err := New4Bits(2)
+	assert.Error(t, err)
+	_, err = New8Bits(2)
+	assert.Error(t, err)
+	_, err = New16Bits(2)
+	assert.Error(t, err)
+
+	for _, interval := range sslfsr.Intervals8Bits()[:10] {
+		register, err := New8Bits(uint8(interval))
+		require.NoError(t, err)
+		expected := sslfsr.NewSSLFSR8(uint8(interval))
+		assert.Equal(t, uint64(sslfsr.CalculateExpectedMaximalLength8Bits(uint8(interval))), register.Period())
+
+		ones := uint64(0)
+		for range register.Period() {
+			register.Next()
+			expected.Next()
+			require.Equal(t, expected.GetRegister()&1, register.Bit())
+			require.Equal(t, expected.GetRegister()>>4&1, register.ClockBit())
+			ones += uint64(register.Bit())
+		}
+		assert.Equal(t, register.Ones(), ones, "interval %d", interval)
+	}
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	gen, err := NewXOR(must4Bits(t, 1), must4Bits(t, 7))
+	require.NoError(t, err)
+	expected, err := NewXOR(must4Bits(t, 1), must4Bits(t, 7))
+	require.NoError(t, err)
+
+	buf := make([]byte, 16)
+	n, err := io.ReadFull(gen, buf)
+	require.NoError(t, err)
+	assert.Equal(t, 16, n)
+
+	for _, b := range buf {
+		for shift := 7; shift >= 0; shift-- {
+			assert.Equal(t, expected.NextBit(), b>>shift&1)
+		}
+	}
+}
+
+func TestLCM(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, int64(1), lcm().Int64())
+	assert.Equal(t, int64(420), lcm(30, 210, 60, 28).Int64())
+}
+
+func must4Bits(t *testing.T, interval uint8) *Register {
+	register, err := New4Bits(interval)
+	require.NoError(t, err)
+
+	return register
+}
+
+// minimalPeriod returns the smallest period of the first n bits of gen, which must repeat within them
+func minimalPeriod(gen Generator, n int) (period int) {
+	bits := make([]uint8, n)
+	for i := range bits {
+		bits[i] = gen.NextBit()
+	}
+
+	for period = 1; period < n; period++ {
+		repeats := true
+		for i := 0; i+period < n && repeats; i++ {
+			repeats = bits[i] == bits[i+period]
+		}
+
+		if repeats {
+			return period
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,40 @@
+package combiner
+
+import "math/big"
+
+// Geffe steps three registers together and outputs the bit of the first or the third, chosen by the
+// bit of the second: x1*x2 + x2*x3 + x3. Each register's bit matches the output 3/4 of the time for
+// the first and third, which is what a correlation attack looks for.
+type Geffe struct {
+	first    *Register
+	selector *Register
+	third    *Register
+}
+
+// NewGeffe constructs a Geffe combiner whose selector picks between first and third
+func NewGeffe(first *Register, selector *Register, third *Register) (gen *Geffe) {
+	return &Geffe{first: first, selector: selector, third: third}
+}
+
+// NextBit steps every register and returns first's bit when selector's is 1, and third's otherwise
+func (gen *Geffe) NextBit() (bit uint8) {
+	gen.first.Next()
+	gen.selector.Next()
+	gen.third.Next()
+
+	if gen.selector.Bit() == 1 {
+		return gen.first.Bit()
+	}
+
+	return gen.third.Bit()
+}
+
+// Period returns the least common multiple of the registers' periods, when they all start over together
+func (gen *Geffe) Period() *big.Int {
+	return lcm(gen.first.Period(), gen.selector.Period(), gen.third.Period())
+}
+
+// Read fills p with the combiner's bits, see Generator
+func (gen *Geffe) Read(p []byte) (n int, err error) {
+	return read(gen, p)
+}
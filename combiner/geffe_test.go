@@ -0,0 +1,27 @@
+package combiner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeffe(t *testing.T) {
+	t.Parallel()
+
+	first, selector, third := must4Bits(t, 1), must4Bits(t, 7), must4Bits(t, 13)
+	gen := NewGeffe(first, selector, third)
+	assert.Equal(t, int64(840), gen.Period().Int64())
+
+	for range 50 {
+		bit := gen.NextBit()
+		if selector.Bit() == 1 {
+			assert.Equal(t, first.Bit(), bit)
+		} else {
+			assert.Equal(t, third.Bit(), bit)
+		}
+	}
+
+	gen = NewGeffe(must4Bits(t, 1), must4Bits(t, 7), must4Bits(t, 13))
+	assert.Equal(t, 840, minimalPeriod(gen, 3*840))
+}
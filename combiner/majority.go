@@ -0,0 +1,52 @@
+package combiner
+
+import "math/big"
+
+// Majority clocks three registers the way A5/1 does: each step, the registers whose ClockBit agrees
+// with the majority of the three step and the other one doesn't, so at least two always step. It
+// outputs the XOR of their bits.
+type Majority struct {
+	registers [3]*Register
+}
+
+// NewMajority constructs a majority clocked combiner of three registers
+func NewMajority(first *Register, second *Register, third *Register) (gen *Majority) {
+	return &Majority{registers: [3]*Register{first, second, third}}
+}
+
+// NextBit steps the registers agreeing with the majority and returns the XOR of every register's bit
+func (gen *Majority) NextBit() (bit uint8) {
+	clocks := [3]uint8{}
+	for i, register := range gen.registers {
+		clocks[i] = register.ClockBit()
+	}
+
+	majority := clocks[0]&clocks[1] | clocks[0]&clocks[2] | clocks[1]&clocks[2]
+	for i, register := range gen.registers {
+		if clocks[i] == majority {
+			register.Next()
+		}
+
+		bit ^= register.Bit()
+	}
+
+	return bit
+}
+
+// Period returns the product of the registers' periods. Unlike the other generators, which register
+// steps depends on the registers, so two states can lead to the same one and the registers may run
+// into a cycle far shorter than this. It's the most steps that can pass before the state repeats, it
+// isn't known how many do without following them.
+func (gen *Majority) Period() *big.Int {
+	product := big.NewInt(1)
+	for _, register := range gen.registers {
+		product.Mul(product, new(big.Int).SetUint64(register.Period()))
+	}
+
+	return product
+}
+
+// Read fills p with the combiner's bits, see Generator
+func (gen *Majority) Read(p []byte) (n int, err error) {
+	return read(gen, p)
+}
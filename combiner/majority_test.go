@@ -0,0 +1,40 @@
+package combiner
+
+import (
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMajority(t *testing.T) {
+	t.Parallel()
+
+	gen := NewMajority(must4Bits(t, 1), must4Bits(t, 7), must4Bits(t, 13))
+	assert.Equal(t, int64(30*120*210), gen.Period().Int64())
+
+	expected := []sslfsr.SSLFSR4{sslfsr.NewSSLFSR4(1), sslfsr.NewSSLFSR4(7), sslfsr.NewSSLFSR4(13)}
+	for range 200 {
+		clocks := 0
+		for _, reg := range expected {
+			clocks += int(reg.GetRegister() >> 2 & 1)
+		}
+		majority := uint8(0)
+		if clocks >= 2 {
+			majority = 1
+		}
+
+		bit := uint8(0)
+		stepped := 0
+		for i := range expected {
+			if expected[i].GetRegister()>>2&1 == majority {
+				expected[i].Next()
+				stepped++
+			}
+			bit ^= expected[i].GetRegister() & 1
+		}
+
+		assert.GreaterOrEqual(t, stepped, 2)
+		assert.Equal(t, bit, gen.NextBit())
+	}
+}
@@ -0,0 +1,250 @@
+package combiner
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/coreyog/sslfsr"
+)
+
+// Target is what a Plan's period has to meet
+type Target struct {
+	AtLeast      *big.Int `json:"at_least,omitempty"`    // smallest acceptable period, nil for any
+	MultipleOf   uint64   `json:"multiple_of,omitempty"` // the period must be a multiple of this, 0 for any
+	Coprime      bool     `json:"coprime"`               // register periods must be pairwise coprime, so the period is their product
+	MaxRegisters int      `json:"max_registers"`         // most registers a plan may use
+	Widths       []int    `json:"widths"`                // widths registers may have, each with KnownIntervals
+}
+
+// DefaultMaxRegisters is the most registers a Plan uses when a Target doesn't say
+const DefaultMaxRegisters = 8
+
+// KnownWidths returns every width with KnownIntervals
+func KnownWidths() (widths []int) {
+	widths = []int{}
+	for width := 2; width <= sslfsr.MaxWidth; width++ {
+		if _, ok := sslfsr.KnownIntervals(width); ok {
+			widths = append(widths, width)
+		}
+	}
+
+	return widths
+}
+
+// Choice is one register of a Plan
+type Choice struct {
+	Width    int    `json:"width"`
+	Interval uint64 `json:"interval"`
+	Period   uint64 `json:"period"` // (2^width-1)(interval+1)
+}
+
+// Plan is a set of registers an XOR combiner runs together, whose period is the least common multiple
+// of theirs
+type Plan struct {
+	Target    Target   `json:"target"`
+	Registers []Choice `json:"registers"`
+	Period    *big.Int `json:"period"`
+}
+
+// NewPlan picks registers whose combined period meets target. It first covers MultipleOf, each time
+// picking the register that covers the most of what's left of it, then grows the period to AtLeast,
+// picking the register reaching it with the smallest period or growing it the most until one can.
+// Choosing greedily, the registers aren't necessarily the fewest possible, and with Coprime an early
+// choice can rule out a plan that would have met the target.
+func NewPlan(target Target) (plan Plan, err error) {
+	if target.MaxRegisters == 0 {
+		target.MaxRegisters = DefaultMaxRegisters
+	}
+	if len(target.Widths) == 0 {
+		target.Widths = KnownWidths()
+	}
+
+	candidates := []Choice{}
+	for _, width := range target.Widths {
+		intervals, ok := sslfsr.KnownIntervals(width)
+		if !ok {
+			return plan, fmt.Errorf("no known intervals for %d bits", width)
+		}
+
+		for _, interval := range intervals {
+			candidates = append(candidates, Choice{
+				Width:    width,
+				Interval: uint64(interval),
+				Period:   (1<<width - 1) * uint64(interval+1),
+			})
+		}
+	}
+
+	plan = Plan{Target: target, Registers: []Choice{}, Period: big.NewInt(1)}
+	multipleOf := new(big.Int).SetUint64(max(target.MultipleOf, 1))
+	atLeast := big.NewInt(1)
+	if target.AtLeast != nil {
+		atLeast = target.AtLeast
+	}
+
+	// what's left of MultipleOf to cover
+	remaining := func(period *big.Int) *big.Int {
+		gcd := new(big.Int).GCD(nil, nil, multipleOf, period)
+		return gcd.Div(multipleOf, gcd)
+	}
+
+	for remaining(plan.Period).Cmp(big.NewInt(1)) != 0 {
+		best, ok := plan.pick(candidates, func(period *big.Int, bestPeriod *big.Int) bool {
+			return remaining(period).Cmp(remaining(bestPeriod)) < 0 || remaining(period).Cmp(remaining(bestPeriod)) == 0 && period.Cmp(bestPeriod) < 0
+		})
+		if !ok || remaining(best).Cmp(remaining(plan.Period)) == 0 {
+			return plan, fmt.Errorf("no register periods can make a multiple of %d", target.MultipleOf)
+		}
+
+		plan.add(candidates, best)
+		if len(plan.Registers) > target.MaxRegisters {
+			return plan, fmt.Errorf("a multiple of %d needs more than %d registers", target.MultipleOf, target.MaxRegisters)
+		}
+	}
+
+	// a period of 1 needs no register, but a plan always has at least one
+	for plan.Period.Cmp(atLeast) < 0 || len(plan.Registers) == 0 {
+		best, ok := plan.pick(candidates, func(period *big.Int, bestPeriod *big.Int) bool {
+			reaches, bestReaches := period.Cmp(atLeast) >= 0, bestPeriod.Cmp(atLeast) >= 0
+			switch {
+			case reaches != bestReaches:
+				return reaches
+			case reaches:
+				return period.Cmp(bestPeriod) < 0
+			default:
+				return period.Cmp(bestPeriod) > 0
+			}
+		})
+		if !ok || best.Cmp(plan.Period) == 0 {
+			return plan, fmt.Errorf("no register periods can reach %s", atLeast)
+		}
+
+		plan.add(candidates, best)
+		if len(plan.Registers) > target.MaxRegisters {
+			return plan, fmt.Errorf("a period of at least %s needs more than %d registers", atLeast, target.MaxRegisters)
+		}
+	}
+
+	return plan, nil
+}
+
+// pick returns the plan's period with the candidate that's better than the rest, by better, allowed
+// alongside the registers chosen so far
+func (plan *Plan) pick(candidates []Choice, better func(period *big.Int, bestPeriod *big.Int) bool) (best *big.Int, ok bool) {
+	for _, candidate := range candidates {
+		period, allowed := plan.with(candidate)
+		if allowed && (!ok || better(period, best)) {
+			best, ok = period, true
+		}
+	}
+
+	return best, ok
+}
+
+// add chooses the first candidate giving the plan period, the one pick found
+func (plan *Plan) add(candidates []Choice, period *big.Int) {
+	for _, candidate := range candidates {
+		if p, allowed := plan.with(candidate); allowed && p.Cmp(period) == 0 {
+			plan.Registers = append(plan.Registers, candidate)
+			plan.Period = p
+			return
+		}
+	}
+}
+
+// with returns the plan's period with candidate added, allowed is false when the target rules it out
+func (plan *Plan) with(candidate Choice) (period *big.Int, allowed bool) {
+	p := new(big.Int).SetUint64(candidate.Period)
+	gcd := new(big.Int).GCD(nil, nil, plan.Period, p)
+
+	if plan.Target.Coprime && gcd.Cmp(big.NewInt(1)) != 0 {
+		return nil, false
+	}
+
+	for _, chosen := range plan.Registers {
+		if chosen.Width == candidate.Width && chosen.Interval == candidate.Interval {
+			return nil, false
+		}
+	}
+
+	return p.Mul(plan.Period, p.Div(p, gcd)), true
+}
+
+// Verify proves every register's interval gives it its period, and that together they meet the target
+func (plan Plan) Verify() (err error) {
+	periods := make([]uint64, len(plan.Registers))
+	for i, choice := range plan.Registers {
+		reg, err := sslfsr.NewSSLFSR(choice.Width, choice.Interval)
+		if err != nil {
+			return err
+		}
+
+		if !reg.VerifyInterval() {
+			return fmt.Errorf("interval %d doesn't give %d bits a maximal period", choice.Interval, choice.Width)
+		}
+
+		if reg.CalculateExpectedMaximalLength() != choice.Period {
+			return fmt.Errorf("%d bits with interval %d has a period of %d, not %d", choice.Width, choice.Interval, reg.CalculateExpectedMaximalLength(), choice.Period)
+		}
+
+		if plan.Target.Coprime && slices.ContainsFunc(periods[:i], func(period uint64) bool {
+			return new(big.Int).GCD(nil, nil, new(big.Int).SetUint64(period), new(big.Int).SetUint64(choice.Period)).Cmp(big.NewInt(1)) != 0
+		}) {
+			return fmt.Errorf("period %d isn't coprime with the others", choice.Period)
+		}
+
+		periods[i] = choice.Period
+	}
+
+	period := lcm(periods...)
+	switch {
+	case period.Cmp(plan.Period) != 0:
+		return fmt.Errorf("the registers' periods have a least common multiple of %s, not %s", period, plan.Period)
+	case plan.Target.AtLeast != nil && period.Cmp(plan.Target.AtLeast) < 0:
+		return fmt.Errorf("period %s is less than %s", period, plan.Target.AtLeast)
+	case plan.Target.MultipleOf > 1 && new(big.Int).Mod(period, new(big.Int).SetUint64(plan.Target.MultipleOf)).Sign() != 0:
+		return fmt.Errorf("period %s isn't a multiple of %d", period, plan.Target.MultipleOf)
+	}
+
+	return nil
+}
+
+// Generator builds the plan's registers and an XOR combiner of them
+func (plan Plan) Generator() (gen *XOR, err error) {
+	registers := make([]*Register, len(plan.Registers))
+	for i, choice := range plan.Registers {
+		registers[i], err = NewWidth(choice.Width, choice.Interval)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// one register is allowed here, a plan may not need more
+	return &XOR{registers: registers}, nil
+}
+
+// WriteText writes the plan's registers and period in a table
+func (plan Plan) WriteText(out io.Writer) (err error) {
+	wants := []string{}
+	if plan.Target.AtLeast != nil {
+		wants = append(wants, "at least "+plan.Target.AtLeast.String())
+	}
+	if plan.Target.MultipleOf > 1 {
+		wants = append(wants, fmt.Sprintf("a multiple of %d", plan.Target.MultipleOf))
+	}
+	if plan.Target.Coprime {
+		wants = append(wants, "pairwise coprime")
+	}
+
+	_, _ = fmt.Fprintf(out, "period %s\n", strings.Join(wants, ", "))
+	_, _ = fmt.Fprintf(out, "%5s  %8s  %12s\n", "width", "interval", "period")
+	for _, choice := range plan.Registers {
+		_, _ = fmt.Fprintf(out, "%5d  %8d  %12d\n", choice.Width, choice.Interval, choice.Period)
+	}
+	_, err = fmt.Fprintf(out, "total period %s\n", plan.Period)
+
+	return err
+}
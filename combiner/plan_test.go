@@ -0,0 +1,129 @@
+package combiner
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanMultipleOf(t *testing.T) {
+	t.Parallel()
+
+	// 2^7-1 is prime, and interval 61 makes 31 the other factor
+	plan, err := NewPlan(Target{MultipleOf: 31 * 127})
+	require.NoError(t, err)
+	require.NoError(t, plan.Verify())
+	assert.Equal(t, []Choice{{Width: 7, Interval: 61, Period: 127 * 62}}, plan.Registers)
+
+	// coprime periods multiply
+	plan, err = NewPlan(Target{MultipleOf: 31 * 127 * 9, Coprime: true, Widths: []int{5, 6, 7}})
+	require.NoError(t, err)
+	require.NoError(t, plan.Verify())
+	product := big.NewInt(1)
+	for _, choice := range plan.Registers {
+		product.Mul(product, new(big.Int).SetUint64(choice.Period))
+	}
+	assert.Equal(t, product, plan.Period)
+	assert.Zero(t, new(big.Int).Mod(plan.Period, big.NewInt(31*127*9)).Int64())
+}
+
+func TestPlanAtLeast(t *testing.T) {
+	t.Parallel()
+
+	atLeast, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	plan, err := NewPlan(Target{AtLeast: atLeast, MultipleOf: 1000})
+	require.NoError(t, err)
+	require.NoError(t, plan.Verify())
+	assert.GreaterOrEqual(t, plan.Period.Cmp(atLeast), 0)
+	assert.Zero(t, new(big.Int).Mod(plan.Period, big.NewInt(1000)).Int64())
+	assert.LessOrEqual(t, len(plan.Registers), DefaultMaxRegisters)
+
+	gen, err := plan.Generator()
+	require.NoError(t, err)
+	assert.Equal(t, plan.Period, gen.Period())
+}
+
+func TestPlanGenerator(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlan(Target{AtLeast: big.NewInt(2000), Widths: []int{4, 5}})
+	require.NoError(t, err)
+	require.NoError(t, plan.Verify())
+
+	gen, err := plan.Generator()
+	require.NoError(t, err)
+	assert.Equal(t, plan.Period, gen.Period())
+
+	period := int(plan.Period.Int64())
+	assert.Equal(t, period, minimalPeriod(gen, 3*period))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, plan.WriteText(buf))
+	assert.Contains(t, buf.String(), "total period "+plan.Period.String())
+}
+
+func TestPlanAnyPeriod(t *testing.T) {
+	t.Parallel()
+
+	// any one register meets a period of 1, the known one with the smallest period is picked
+	for _, target := range []Target{{AtLeast: big.NewInt(1)}, {AtLeast: big.NewInt(0)}, {}} {
+		plan, err := NewPlan(target)
+		require.NoError(t, err)
+		require.Len(t, plan.Registers, 1)
+		assert.Equal(t, Choice{Width: 4, Interval: 1, Period: 30}, plan.Registers[0])
+		assert.NoError(t, plan.Verify())
+	}
+}
+
+func TestPlanImpossible(t *testing.T) {
+	t.Parallel()
+
+	// every 4, 8 and 16 bit period is a multiple of 15, so only one can be used
+	_, err := NewPlan(Target{AtLeast: big.NewInt(10_000_000_000), Coprime: true, Widths: []int{4, 8, 16}})
+	assert.Error(t, err)
+
+	// interval+1 never has more than 2^15 as a factor
+	_, err = NewPlan(Target{MultipleOf: 1 << 40, MaxRegisters: 2})
+	assert.Error(t, err)
+
+	_, err = NewPlan(Target{MultipleOf: 3, Widths: []int{9}})
+	assert.Error(t, err)
+}
+
+func TestVerifyPlan(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlan(Target{MultipleOf: 31 * 127 * 9, Coprime: true, Widths: []int{5, 6, 7}})
+	require.NoError(t, err)
+
+	period := plan.Period
+	plan.Period = new(big.Int).Add(period, big.NewInt(1))
+	assert.Error(t, plan.Verify())
+	plan.Period = period
+
+	plan.Registers[0].Interval++
+	assert.Error(t, plan.Verify())
+}
+
+func TestNewWidth(t *testing.T) {
+	t.Parallel()
+
+	register, err := NewWidth(8, 63)
+	require.NoError(t, err)
+	expected, err := New8Bits(63)
+	require.NoError(t, err)
+
+	for range 1000 {
+		register.Next()
+		expected.Next()
+		require.Equal(t, expected.GetRegister(), register.GetRegister())
+	}
+
+	_, err = NewWidth(8, 2)
+	assert.Error(t, err)
+	_, err = NewWidth(9, 1)
+	assert.Error(t, err)
+}
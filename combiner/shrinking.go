@@ -0,0 +1,41 @@
+package combiner
+
+import "math/big"
+
+// Shrinking steps two registers together and outputs the source's bit only when the control's bit
+// is 1, dropping it otherwise
+type Shrinking struct {
+	control *Register
+	source  *Register
+}
+
+// NewShrinking constructs a shrinking generator whose control decides which of source's bits are kept
+func NewShrinking(control *Register, source *Register) (gen *Shrinking) {
+	return &Shrinking{control: control, source: source}
+}
+
+// NextBit steps both registers until control's bit is 1 and returns source's bit
+func (gen *Shrinking) NextBit() (bit uint8) {
+	for {
+		gen.control.Next()
+		gen.source.Next()
+
+		if gen.control.Bit() == 1 {
+			return gen.source.Bit()
+		}
+	}
+}
+
+// Period returns how many bits come out before both registers start over together, the control's
+// Ones for each of its periods in the least common multiple of theirs
+func (gen *Shrinking) Period() *big.Int {
+	multiple := lcm(gen.control.Period(), gen.source.Period())
+	multiple.Div(multiple, new(big.Int).SetUint64(gen.control.Period()))
+
+	return multiple.Mul(multiple, new(big.Int).SetUint64(gen.control.Ones()))
+}
+
+// Read fills p with the generator's bits, see Generator
+func (gen *Shrinking) Read(p []byte) (n int, err error) {
+	return read(gen, p)
+}
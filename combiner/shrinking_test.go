@@ -0,0 +1,29 @@
+package combiner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShrinking(t *testing.T) {
+	t.Parallel()
+
+	control, source := must4Bits(t, 1), must4Bits(t, 7)
+	gen := NewShrinking(control, source)
+
+	for range 50 {
+		bit := gen.NextBit()
+		assert.Equal(t, uint8(1), control.Bit())
+		assert.Equal(t, source.Bit(), bit)
+	}
+
+	// the control has 16 ones in each of its 30 steps, 4 of its periods pass for each of source's 120
+	gen = NewShrinking(must4Bits(t, 1), must4Bits(t, 7))
+	assert.Equal(t, int64(64), gen.Period().Int64())
+	assert.Equal(t, 64, minimalPeriod(gen, 3*64))
+
+	gen = NewShrinking(must4Bits(t, 7), must4Bits(t, 11))
+	assert.Equal(t, int64(8*8*3), gen.Period().Int64())
+	assert.Equal(t, 8*8*3, minimalPeriod(gen, 3*8*8*3))
+}
@@ -0,0 +1,45 @@
+package combiner
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// XOR steps every one of its registers together and outputs the XOR of their bits
+type XOR struct {
+	registers []*Register
+}
+
+// NewXOR constructs an XOR combiner of at least two registers
+func NewXOR(registers ...*Register) (gen *XOR, err error) {
+	if len(registers) < 2 {
+		return nil, fmt.Errorf("at least two registers are needed, not %d", len(registers))
+	}
+
+	return &XOR{registers: registers}, nil
+}
+
+// NextBit steps every register and returns the XOR of their bits
+func (gen *XOR) NextBit() (bit uint8) {
+	for _, register := range gen.registers {
+		register.Next()
+		bit ^= register.Bit()
+	}
+
+	return bit
+}
+
+// Period returns the least common multiple of the registers' periods, when they all start over together
+func (gen *XOR) Period() *big.Int {
+	periods := make([]uint64, len(gen.registers))
+	for i, register := range gen.registers {
+		periods[i] = register.Period()
+	}
+
+	return lcm(periods...)
+}
+
+// Read fills p with the combiner's bits, see Generator
+func (gen *XOR) Read(p []byte) (n int, err error) {
+	return read(gen, p)
+}
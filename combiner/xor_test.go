@@ -0,0 +1,30 @@
+package combiner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXOR(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewXOR(must4Bits(t, 1))
+	assert.Error(t, err)
+
+	first, second := must4Bits(t, 1), must4Bits(t, 7)
+	gen, err := NewXOR(first, second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(120), gen.Period().Int64())
+
+	for range 50 {
+		bit := gen.NextBit()
+		assert.Equal(t, first.Bit()^second.Bit(), bit)
+	}
+
+	gen, err = NewXOR(must4Bits(t, 1), must4Bits(t, 7), must4Bits(t, 13))
+	require.NoError(t, err)
+	assert.Equal(t, int64(840), gen.Period().Int64())
+	assert.Equal(t, 840, minimalPeriod(gen, 3*840))
+}
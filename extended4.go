@@ -0,0 +1,95 @@
+package sslfsr
+
+// ExtendedSSLFSR4 is an SSLFSR4 that also reaches register 0, the way a de Bruijn sequence extends an
+// m-sequence. Instead of SubShifting back to register 1 with the counter at 0, where every period
+// starts, Next() stops at register 0 for a call first, so every one of the 16 registers comes up in
+// a period.
+type ExtendedSSLFSR4 struct {
+	register uint8
+	interval uint8
+	counter  uint8
+}
+
+// NewExtendedSSLFSR4 constructs an ExtendedSSLFSR4 with a given interval
+func NewExtendedSSLFSR4(interval uint8) (sslfsr ExtendedSSLFSR4) {
+	return BuildExtendedSSLFSR4(1, interval, 0)
+}
+
+// BuildExtendedSSLFSR4 constructs an ExtendedSSLFSR4 with a given register, interval, and counter
+func BuildExtendedSSLFSR4(register uint8, interval uint8, counter uint8) (sslfsr ExtendedSSLFSR4) {
+	return ExtendedSSLFSR4{
+		register: register,
+		interval: interval,
+		counter:  counter,
+	}
+}
+
+// GetRegister returns the current register value
+func (sslfsr *ExtendedSSLFSR4) GetRegister() uint8 {
+	return sslfsr.register
+}
+
+// GetInterval returns the interval this ExtendedSSLFSR4 was constructed with
+func (sslfsr *ExtendedSSLFSR4) GetInterval() uint8 {
+	return sslfsr.interval
+}
+
+// GetCounter returns the current counter value
+func (sslfsr *ExtendedSSLFSR4) GetCounter() uint8 {
+	return sslfsr.counter
+}
+
+// Next Shifts or SubShifts according to the Counter and Interval like SSLFSR4, except a SubShift to
+// register 1 goes to register 0 instead, and the call after that goes on to register 1 with the
+// counter left at 0
+func (sslfsr *ExtendedSSLFSR4) Next() {
+	sslfsr.register, sslfsr.counter = extended4Bits(sslfsr.register, sslfsr.interval, sslfsr.counter)
+}
+
+// extended4Bits is the register and counter after ExtendedSSLFSR4.Next()
+func extended4Bits(register uint8, interval uint8, counter uint8) (uint8, uint8) {
+	switch {
+	case register == 0:
+		return 1, counter
+	case counter == interval:
+		register = SubShift4Bits(register)
+		if register == 1 {
+			return 0, 0
+		}
+		return register, 0
+	default:
+		return Shift4Bits(register), counter + 1
+	}
+}
+
+// CalculateExpectedMaximalLength calculates the total state count if the Interval were an optimal
+// Interval, see CalculateExpectedExtendedMaximalLength4Bits
+func (sslfsr *ExtendedSSLFSR4) CalculateExpectedMaximalLength() (stateCount int) {
+	return CalculateExpectedExtendedMaximalLength4Bits(sslfsr.interval)
+}
+
+// CalculateExpectedExtendedMaximalLength4Bits calculates the total state count if interval were an
+// optimal Interval, an SSLFSR4's and one more for register 0
+func CalculateExpectedExtendedMaximalLength4Bits(interval uint8) (stateCount int) {
+	return MaxUint4*(int(interval)+1) + 1
+}
+
+// CalculatePeriod calculates how many Next() calls an ExtendedSSLFSR4 with this Interval takes to return
+// to register 1 with the counter at 0, see CalculateExtendedPeriod4Bits
+func (sslfsr *ExtendedSSLFSR4) CalculatePeriod() (period int) {
+	return CalculateExtendedPeriod4Bits(sslfsr.interval)
+}
+
+// CalculateExtendedPeriod4Bits calculates how many Next() calls NewExtendedSSLFSR4(interval) takes to
+// return to its starting state by making them
+func CalculateExtendedPeriod4Bits(interval uint8) (period int) {
+	register, counter := extended4Bits(1, interval, 0)
+	period = 1
+
+	for register != 1 || counter != 0 {
+		register, counter = extended4Bits(register, interval, counter)
+		period++
+	}
+
+	return period
+}
@@ -0,0 +1,66 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendedSSLFSR4Period(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range Intervals4Bits() {
+		reg := NewExtendedSSLFSR4(uint8(interval))
+		seen := [16]int{}
+
+		calls := 0
+		for {
+			reg.Next()
+			calls++
+			seen[reg.GetRegister()]++
+
+			if reg.GetRegister() == 1 && reg.GetCounter() == 0 {
+				break
+			}
+		}
+
+		assert.Equal(t, reg.CalculateExpectedMaximalLength(), calls, "interval %d", interval)
+		assert.Equal(t, calls, reg.CalculatePeriod(), "interval %d", interval)
+		assert.Equal(t, 1, seen[0], "interval %d", interval)
+		for register := 1; register < 16; register++ {
+			assert.Equal(t, interval+1, seen[register], "interval %d register %d", interval, register)
+		}
+	}
+}
+
+func TestExtendedSSLFSR4MatchesSSLFSR4(t *testing.T) {
+	t.Parallel()
+
+	// leaving out the call at register 0, it's an SSLFSR that makes the same calls
+	reg := NewExtendedSSLFSR4(7)
+	expected := NewSSLFSR4(7)
+	for range 3 * CalculateExpectedMaximalLength4Bits(7) {
+		reg.Next()
+		if reg.GetRegister() == 0 {
+			assert.Equal(t, uint8(0), reg.GetCounter())
+			reg.Next()
+		}
+
+		expected.Next()
+		assert.Equal(t, expected.GetRegister(), reg.GetRegister())
+		assert.Equal(t, expected.GetCounter(), reg.GetCounter())
+	}
+}
+
+func TestExtendedSSLFSR4NonOptimal(t *testing.T) {
+	t.Parallel()
+
+	// register 1 never comes back around through every register, so neither does register 0
+	reg := NewExtendedSSLFSR4(2)
+	assert.Less(t, reg.CalculatePeriod(), reg.CalculateExpectedMaximalLength())
+
+	built := BuildExtendedSSLFSR4(0xA, 2, 1)
+	assert.Equal(t, uint8(0xA), built.GetRegister())
+	assert.Equal(t, uint8(2), built.GetInterval())
+	assert.Equal(t, uint8(1), built.GetCounter())
+}
@@ -0,0 +1,97 @@
+package sslfsr
+
+import "math"
+
+// ExtendedSSLFSR8 is an SSLFSR8 that also reaches register 0, the way a de Bruijn sequence extends an
+// m-sequence. Instead of SubShifting back to register 1 with the counter at 0, where every period
+// starts, Next() stops at register 0 for a call first, so every one of the 256 registers comes up in
+// a period.
+type ExtendedSSLFSR8 struct {
+	register uint8
+	interval uint8
+	counter  uint8
+}
+
+// NewExtendedSSLFSR8 constructs an ExtendedSSLFSR8 with a given interval
+func NewExtendedSSLFSR8(interval uint8) (sslfsr ExtendedSSLFSR8) {
+	return BuildExtendedSSLFSR8(1, interval, 0)
+}
+
+// BuildExtendedSSLFSR8 constructs an ExtendedSSLFSR8 with a given register, interval, and counter
+func BuildExtendedSSLFSR8(register uint8, interval uint8, counter uint8) (sslfsr ExtendedSSLFSR8) {
+	return ExtendedSSLFSR8{
+		register: register,
+		interval: interval,
+		counter:  counter,
+	}
+}
+
+// GetRegister returns the current register value
+func (sslfsr *ExtendedSSLFSR8) GetRegister() uint8 {
+	return sslfsr.register
+}
+
+// GetInterval returns the interval this ExtendedSSLFSR8 was constructed with
+func (sslfsr *ExtendedSSLFSR8) GetInterval() uint8 {
+	return sslfsr.interval
+}
+
+// GetCounter returns the current counter value
+func (sslfsr *ExtendedSSLFSR8) GetCounter() uint8 {
+	return sslfsr.counter
+}
+
+// Next Shifts or SubShifts according to the Counter and Interval like SSLFSR8, except a SubShift to
+// register 1 goes to register 0 instead, and the call after that goes on to register 1 with the
+// counter left at 0
+func (sslfsr *ExtendedSSLFSR8) Next() {
+	sslfsr.register, sslfsr.counter = extended8Bits(sslfsr.register, sslfsr.interval, sslfsr.counter)
+}
+
+// extended8Bits is the register and counter after ExtendedSSLFSR8.Next()
+func extended8Bits(register uint8, interval uint8, counter uint8) (uint8, uint8) {
+	switch {
+	case register == 0:
+		return 1, counter
+	case counter == interval:
+		register = SubShift8Bits(register)
+		if register == 1 {
+			return 0, 0
+		}
+		return register, 0
+	default:
+		return Shift8Bits(register), counter + 1
+	}
+}
+
+// CalculateExpectedMaximalLength calculates the total state count if the Interval were an optimal
+// Interval, see CalculateExpectedExtendedMaximalLength8Bits
+func (sslfsr *ExtendedSSLFSR8) CalculateExpectedMaximalLength() (stateCount int) {
+	return CalculateExpectedExtendedMaximalLength8Bits(sslfsr.interval)
+}
+
+// CalculateExpectedExtendedMaximalLength8Bits calculates the total state count if interval were an
+// optimal Interval, an SSLFSR8's and one more for register 0
+func CalculateExpectedExtendedMaximalLength8Bits(interval uint8) (stateCount int) {
+	return math.MaxUint8*(int(interval)+1) + 1
+}
+
+// CalculatePeriod calculates how many Next() calls an ExtendedSSLFSR8 with this Interval takes to return
+// to register 1 with the counter at 0, see CalculateExtendedPeriod8Bits
+func (sslfsr *ExtendedSSLFSR8) CalculatePeriod() (period int) {
+	return CalculateExtendedPeriod8Bits(sslfsr.interval)
+}
+
+// CalculateExtendedPeriod8Bits calculates how many Next() calls NewExtendedSSLFSR8(interval) takes to
+// return to its starting state by making them
+func CalculateExtendedPeriod8Bits(interval uint8) (period int) {
+	register, counter := extended8Bits(1, interval, 0)
+	period = 1
+
+	for register != 1 || counter != 0 {
+		register, counter = extended8Bits(register, interval, counter)
+		period++
+	}
+
+	return period
+}
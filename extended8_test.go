@@ -0,0 +1,66 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendedSSLFSR8Period(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range Intervals8Bits() {
+		reg := NewExtendedSSLFSR8(uint8(interval))
+		seen := [256]int{}
+
+		calls := 0
+		for {
+			reg.Next()
+			calls++
+			seen[reg.GetRegister()]++
+
+			if reg.GetRegister() == 1 && reg.GetCounter() == 0 {
+				break
+			}
+		}
+
+		assert.Equal(t, reg.CalculateExpectedMaximalLength(), calls, "interval %d", interval)
+		assert.Equal(t, calls, reg.CalculatePeriod(), "interval %d", interval)
+		assert.Equal(t, 1, seen[0], "interval %d", interval)
+		for register := 1; register < 256; register++ {
+			assert.Equal(t, interval+1, seen[register], "interval %d register %d", interval, register)
+		}
+	}
+}
+
+func TestExtendedSSLFSR8MatchesSSLFSR8(t *testing.T) {
+	t.Parallel()
+
+	// leaving out the call at register 0, it's an SSLFSR that makes the same calls
+	reg := NewExtendedSSLFSR8(29)
+	expected := NewSSLFSR8(29)
+	for range 3 * CalculateExpectedMaximalLength8Bits(29) {
+		reg.Next()
+		if reg.GetRegister() == 0 {
+			assert.Equal(t, uint8(0), reg.GetCounter())
+			reg.Next()
+		}
+
+		expected.Next()
+		assert.Equal(t, expected.GetRegister(), reg.GetRegister())
+		assert.Equal(t, expected.GetCounter(), reg.GetCounter())
+	}
+}
+
+func TestExtendedSSLFSR8NonOptimal(t *testing.T) {
+	t.Parallel()
+
+	// register 1 never comes back around through every register, so neither does register 0
+	reg := NewExtendedSSLFSR8(2)
+	assert.Less(t, reg.CalculatePeriod(), reg.CalculateExpectedMaximalLength())
+
+	built := BuildExtendedSSLFSR8(0x5A, 2, 1)
+	assert.Equal(t, uint8(0x5A), built.GetRegister())
+	assert.Equal(t, uint8(2), built.GetInterval())
+	assert.Equal(t, uint8(1), built.GetCounter())
+}
@@ -0,0 +1,70 @@
+package filter
+
+import (
+	"fmt"
+	"slices"
+)
+
+// State is a register a Filter can step and read, e.g. a combiner.Register or sslfsr.NestedSSLFSR
+type State interface {
+	Next()
+	GetRegister() uint64
+}
+
+// Filter is a filter generator: after each step of its state it outputs a Boolean function of some
+// of the register's bits
+type Filter struct {
+	state    State
+	function Function
+	taps     []int // taps[i] is the register bit fed to input i
+}
+
+// New constructs a Filter applying function to the register bits taps, one per input
+func New(state State, function Function, taps ...int) (filter *Filter, err error) {
+	if len(taps) != function.Inputs() {
+		return nil, fmt.Errorf("%d taps for a function of %d inputs", len(taps), function.Inputs())
+	}
+
+	for i, tap := range taps {
+		if tap < 0 || tap >= 64 {
+			return nil, fmt.Errorf("tap %d isn't a register bit", tap)
+		}
+
+		if slices.Contains(taps[:i], tap) {
+			return nil, fmt.Errorf("tap %d is used twice", tap)
+		}
+	}
+
+	return &Filter{state: state, function: function, taps: slices.Clone(taps)}, nil
+}
+
+// NextBit steps the state and returns the function of the tapped bits
+func (filter *Filter) NextBit() (bit uint8) {
+	filter.state.Next()
+	register := filter.state.GetRegister()
+
+	x := uint64(0)
+	for i, tap := range filter.taps {
+		x |= (register >> tap & 1) << i
+	}
+
+	return filter.function.Eval(x)
+}
+
+// NextByte returns the next 8 bits, the first the most significant
+func (filter *Filter) NextByte() (b byte) {
+	for range 8 {
+		b = b<<1 | filter.NextBit()
+	}
+
+	return b
+}
+
+// Read fills p with the filter's bytes, see NextByte
+func (filter *Filter) Read(p []byte) (n int, err error) {
+	for i := range p {
+		p[i] = filter.NextByte()
+	}
+
+	return len(p), nil
+}
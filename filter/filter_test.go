@@ -0,0 +1,67 @@
+package filter
+
+import (
+	"io"
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/coreyog/sslfsr/combiner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	register, err := combiner.New8Bits(63)
+	require.NoError(t, err)
+	filter, err := New(register, Geffe(), 1, 6, 3)
+	require.NoError(t, err)
+
+	expected := sslfsr.NewSSLFSR8(63)
+	for range 300 {
+		expected.Next()
+		r := expected.GetRegister()
+		x := uint64(r>>1&1 | r>>6&1<<1 | r>>3&1<<2)
+		require.Equal(t, Geffe().Eval(x), filter.NextBit())
+	}
+}
+
+func TestFilterRead(t *testing.T) {
+	t.Parallel()
+
+	majority, err := Majority(3)
+	require.NoError(t, err)
+
+	nested, err := sslfsr.NewNestedSSLFSR(16, sslfsr.NestedLevel{Interval: 5, Shifts: 2})
+	require.NoError(t, err)
+	filter, err := New(&nested, majority, 0, 7, 12)
+	require.NoError(t, err)
+
+	same, err := sslfsr.NewNestedSSLFSR(16, sslfsr.NestedLevel{Interval: 5, Shifts: 2})
+	require.NoError(t, err)
+	expected, err := New(&same, majority, 0, 7, 12)
+	require.NoError(t, err)
+
+	buf := make([]byte, 32)
+	_, err = io.ReadFull(filter, buf)
+	require.NoError(t, err)
+
+	for _, b := range buf {
+		assert.Equal(t, expected.NextByte(), b)
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+	t.Parallel()
+
+	register, err := combiner.New8Bits(63)
+	require.NoError(t, err)
+
+	_, err = New(register, Geffe(), 1, 2)
+	assert.Error(t, err)
+	_, err = New(register, Geffe(), 1, 2, 1)
+	assert.Error(t, err)
+	_, err = New(register, Geffe(), 1, 2, 64)
+	assert.Error(t, err)
+}
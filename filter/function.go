@@ -0,0 +1,119 @@
+// Package filter turns a register into a filter generator, whose output is a Boolean function of
+// some of the register's bits after each step, and measures how well a function hides the register.
+package filter
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/coreyog/sslfsr"
+)
+
+// MaxInputs is the most inputs a Function's truth table is kept for
+const MaxInputs = 16
+
+// Function is a Boolean function of up to MaxInputs bits, kept as its truth table
+type Function struct {
+	inputs int
+	table  []uint8 // table[x] is the value for the inputs x, input i being bit i of x
+}
+
+// FromFunc builds a Function of the given number of inputs from the lowest bit of f
+func FromFunc(inputs int, f func(x uint64) uint8) (function Function, err error) {
+	if inputs < 1 || inputs > MaxInputs {
+		return function, fmt.Errorf("inputs must be between 1 and %d, not %d", MaxInputs, inputs)
+	}
+
+	function = Function{inputs: inputs, table: make([]uint8, 1<<inputs)}
+	for x := range function.table {
+		function.table[x] = f(uint64(x)) & 1
+	}
+
+	return function, nil
+}
+
+// FromANF builds a Function of the given number of inputs from a polynomial of them, see sslfsr.ANF
+func FromANF(inputs int, anf sslfsr.ANF) (function Function, err error) {
+	for _, term := range anf {
+		if bits.Len64(term) > inputs {
+			return function, fmt.Errorf("%s uses more than %d inputs", anf, inputs)
+		}
+	}
+
+	return FromFunc(inputs, func(x uint64) uint8 {
+		return uint8(anf.Eval(x))
+	})
+}
+
+// Inputs returns how many inputs the function takes
+func (function Function) Inputs() int {
+	return function.inputs
+}
+
+// Eval returns the function's value for the inputs x, input i being bit i of x
+func (function Function) Eval(x uint64) uint8 {
+	return function.table[x]
+}
+
+// ANF returns the function's polynomial, by the Moebius transform of its truth table
+func (function Function) ANF() (anf sslfsr.ANF) {
+	coefficients := make([]uint8, len(function.table))
+	copy(coefficients, function.table)
+
+	for step := 1; step < len(coefficients); step <<= 1 {
+		for x := range coefficients {
+			if x&step != 0 {
+				coefficients[x] ^= coefficients[x^step]
+			}
+		}
+	}
+
+	terms := []uint64{}
+	for term, coefficient := range coefficients {
+		if coefficient == 1 {
+			terms = append(terms, uint64(term))
+		}
+	}
+
+	return sslfsr.NewANF(terms...)
+}
+
+// Linear is the XOR of every input, perfectly balanced and perfectly predictable
+func Linear(inputs int) (function Function, err error) {
+	return FromFunc(inputs, func(x uint64) uint8 {
+		return uint8(bits.OnesCount64(x) % 2)
+	})
+}
+
+// Majority is 1 when more than half its inputs are
+func Majority(inputs int) (function Function, err error) {
+	return FromFunc(inputs, func(x uint64) uint8 {
+		if 2*bits.OnesCount64(x) > inputs {
+			return 1
+		}
+
+		return 0
+	})
+}
+
+// InnerProduct is x0*x1 + x2*x3 + ..., a bent function for an even number of inputs: as far from
+// every linear function as a function can be, though not balanced
+func InnerProduct(inputs int) (function Function, err error) {
+	return FromFunc(inputs, func(x uint64) uint8 {
+		pairs := x & (x >> 1) & 0x5555555555555555
+		return uint8(bits.OnesCount64(pairs) % 2)
+	})
+}
+
+// Geffe is x0*x1 + x1*x2 + x2, input 1 choosing between inputs 0 and 2, see combiner.Geffe
+func Geffe() (function Function) {
+	function, _ = FromFunc(3, func(x uint64) uint8 {
+		if x&0b010 != 0 {
+			return uint8(x & 1)
+		}
+
+		return uint8(x >> 2 & 1)
+	})
+
+	return function
+}
@@ -0,0 +1,45 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromANF(t *testing.T) {
+	t.Parallel()
+
+	anf, err := sslfsr.ParseANF("x0*x1 + x1*x2 + x2")
+	require.NoError(t, err)
+	function, err := FromANF(3, anf)
+	require.NoError(t, err)
+	assert.Equal(t, Geffe(), function)
+	assert.Equal(t, anf, Geffe().ANF())
+
+	_, err = FromANF(2, anf)
+	assert.Error(t, err)
+	_, err = FromFunc(MaxInputs+1, func(uint64) uint8 { return 0 })
+	assert.Error(t, err)
+}
+
+func TestBuiltinFunctions(t *testing.T) {
+	t.Parallel()
+
+	linear, err := Linear(4)
+	require.NoError(t, err)
+	assert.Equal(t, "x0 + x1 + x2 + x3", linear.ANF().String())
+
+	majority, err := Majority(3)
+	require.NoError(t, err)
+	assert.Equal(t, "x0*x1 + x0*x2 + x1*x2", majority.ANF().String())
+
+	inner, err := InnerProduct(4)
+	require.NoError(t, err)
+	assert.Equal(t, "x0*x1 + x2*x3", inner.ANF().String())
+
+	assert.Equal(t, uint8(1), Geffe().Eval(0b011))
+	assert.Equal(t, uint8(0), Geffe().Eval(0b110))
+	assert.Equal(t, uint8(1), Geffe().Eval(0b100))
+}
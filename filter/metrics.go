@@ -0,0 +1,75 @@
+package filter
+
+import "math/bits"
+
+// Metrics measures how well a Function hides the bits it's given
+type Metrics struct {
+	Inputs              int  `json:"inputs"`
+	Degree              int  `json:"degree"`               // algebraic degree, the largest term of its ANF
+	Balanced            bool `json:"balanced"`             // as many inputs give 0 as give 1
+	Nonlinearity        int  `json:"nonlinearity"`         // fewest outputs that differ from any affine function
+	CorrelationImmunity int  `json:"correlation_immunity"` // most inputs its output is statistically independent of together
+}
+
+// Resilient reports whether the function is balanced and correlation immune to order m
+func (metrics Metrics) Resilient(m int) bool {
+	return metrics.Balanced && metrics.CorrelationImmunity >= m
+}
+
+// Measure works out every metric of function from its Walsh spectrum and ANF
+func (function Function) Measure() (metrics Metrics) {
+	walsh := function.Walsh()
+
+	metrics = Metrics{
+		Inputs:              function.inputs,
+		Degree:              function.ANF().Degree(),
+		Balanced:            walsh[0] == 0,
+		Nonlinearity:        nonlinearity(walsh),
+		CorrelationImmunity: correlationImmunity(walsh),
+	}
+
+	return metrics
+}
+
+// Walsh returns the function's Walsh-Hadamard spectrum, walsh[a] is the number of inputs x where the
+// function agrees with the linear function a.x less the number where it doesn't
+func (function Function) Walsh() (walsh []int) {
+	walsh = make([]int, len(function.table))
+	for x, value := range function.table {
+		walsh[x] = 1 - 2*int(value)
+	}
+
+	for step := 1; step < len(walsh); step <<= 1 {
+		for x := range walsh {
+			if x&step == 0 {
+				walsh[x], walsh[x|step] = walsh[x]+walsh[x|step], walsh[x]-walsh[x|step]
+			}
+		}
+	}
+
+	return walsh
+}
+
+// nonlinearity is 2^(n-1) less half the largest magnitude in the spectrum
+func nonlinearity(walsh []int) (distance int) {
+	largest := 0
+	for _, w := range walsh {
+		largest = max(largest, w, -w)
+	}
+
+	return (len(walsh) - largest) / 2
+}
+
+// correlationImmunity is the largest m where the spectrum is 0 for every a of 1 to m bits
+func correlationImmunity(walsh []int) (m int) {
+	inputs := bits.Len(uint(len(walsh))) - 1
+	order := inputs
+
+	for a, w := range walsh {
+		if a != 0 && w != 0 {
+			order = min(order, bits.OnesCount(uint(a))-1)
+		}
+	}
+
+	return order
+}
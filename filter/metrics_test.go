@@ -0,0 +1,41 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasure(t *testing.T) {
+	t.Parallel()
+
+	linear, err := Linear(5)
+	require.NoError(t, err)
+	assert.Equal(t, Metrics{Inputs: 5, Degree: 1, Balanced: true, Nonlinearity: 0, CorrelationImmunity: 4}, linear.Measure())
+	assert.True(t, linear.Measure().Resilient(4))
+
+	majority, err := Majority(3)
+	require.NoError(t, err)
+	assert.Equal(t, Metrics{Inputs: 3, Degree: 2, Balanced: true, Nonlinearity: 2, CorrelationImmunity: 0}, majority.Measure())
+
+	// bent, the most nonlinear a function of 4 inputs can be
+	inner, err := InnerProduct(4)
+	require.NoError(t, err)
+	assert.Equal(t, Metrics{Inputs: 4, Degree: 2, Balanced: false, Nonlinearity: 6, CorrelationImmunity: 0}, inner.Measure())
+	assert.False(t, inner.Measure().Resilient(0))
+
+	// Geffe's output agrees with inputs 0 and 2 3/4 of the time
+	walsh := Geffe().Walsh()
+	assert.Equal(t, 4, walsh[0b001])
+	assert.Equal(t, 4, walsh[0b100])
+	assert.Equal(t, 0, walsh[0b010])
+	assert.Equal(t, Metrics{Inputs: 3, Degree: 2, Balanced: true, Nonlinearity: 2, CorrelationImmunity: 0}, Geffe().Measure())
+
+	// x0 + x1 + x2*x3 is balanced and immune to any one input
+	resilient, err := FromFunc(4, func(x uint64) uint8 {
+		return uint8(x ^ x>>1 ^ (x>>2)&(x>>3))
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Metrics{Inputs: 4, Degree: 2, Balanced: true, Nonlinearity: 4, CorrelationImmunity: 1}, resilient.Measure())
+}
@@ -0,0 +1,14 @@
+package sslfsr
+
+// The Intervals<width>Bits tables are generated by cmd/genintervals from the verified solver results
+// in testdata, see its docs for regenerating them
+
+//go:generate go run ./cmd/genintervals --width 4 testdata/results4.csv
+//go:generate go run ./cmd/genintervals --width 5 testdata/results5.csv
+//go:generate go run ./cmd/genintervals --width 6 testdata/results6.csv
+//go:generate go run ./cmd/genintervals --width 7 testdata/results7.csv
+//go:generate go run ./cmd/genintervals --width 8 testdata/results8.csv
+//go:generate go run ./cmd/genintervals --width 10 testdata/results10.csv
+//go:generate go run ./cmd/genintervals --width 12 testdata/results12.csv
+//go:generate go run ./cmd/genintervals --width 14 testdata/results14.csv
+//go:generate go run ./cmd/genintervals --width 16 testdata/results16.csv
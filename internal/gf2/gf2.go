@@ -0,0 +1,234 @@
+// Package gf2 does linear algebra over GF(2) on registers of up to 64 bits. Shift and SubShift are
+// both linear over GF(2), so any run of them is a single Matrix no matter how many steps it takes.
+package gf2
+
+import "math/bits"
+
+// Matrix is a square matrix over GF(2), column i is the image of the register with only bit i set
+type Matrix struct {
+	cols []uint64
+}
+
+// Identity returns the n by n identity matrix
+func Identity(n int) (m Matrix) {
+	m.cols = make([]uint64, n)
+	for i := range m.cols {
+		m.cols[i] = 1 << i
+	}
+
+	return m
+}
+
+// FromFunc returns the matrix of a linear function on n bit registers
+func FromFunc(n int, f func(uint64) uint64) (m Matrix) {
+	m.cols = make([]uint64, n)
+	for i := range m.cols {
+		m.cols[i] = f(1 << i)
+	}
+
+	return m
+}
+
+// Size returns the number of bits the matrix operates on
+func (m Matrix) Size() int {
+	return len(m.cols)
+}
+
+// Apply multiplies the matrix by a register, which must fit in Size bits
+func (m Matrix) Apply(x uint64) (y uint64) {
+	for i := 0; x != 0; i++ {
+		if x&1 == 1 {
+			y ^= m.cols[i]
+		}
+		x >>= 1
+	}
+
+	return y
+}
+
+// Mul returns the matrix that applies o and then m
+func (m Matrix) Mul(o Matrix) (p Matrix) {
+	p.cols = make([]uint64, len(o.cols))
+	for i, col := range o.cols {
+		p.cols[i] = m.Apply(col)
+	}
+
+	return p
+}
+
+// Pow returns m applied k times
+func (m Matrix) Pow(k uint64) (p Matrix) {
+	p = Identity(m.Size())
+	for square := m; k != 0; k >>= 1 {
+		if k&1 == 1 {
+			p = square.Mul(p)
+		}
+		square = square.Mul(square)
+	}
+
+	return p
+}
+
+// CycleIs reports whether x first comes back to itself after exactly n applications of m. The length
+// of x's cycle is the order of its minimal polynomial, the smallest k with t^k = 1 modulo it, which
+// is n exactly when t^n = 1 and t^(n/p) != 1 for every prime p dividing n. That takes a handful of
+// polynomial multiplications rather than n steps.
+func (m Matrix) CycleIs(x uint64, n uint64) bool {
+	if x == 0 {
+		return n == 1
+	}
+
+	poly := m.MinPoly(x)
+	if n == 0 || poly&1 == 0 {
+		return false // x never comes back when m isn't invertible on its orbit
+	}
+
+	if PolyPowMod(2, n, poly) != 1 {
+		return false
+	}
+
+	for _, p := range PrimeFactors(n) {
+		if PolyPowMod(2, n/p, poly) == 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MinPoly returns the lowest degree polynomial p, bit i holding the coefficient of t^i, for which
+// p(m) applied to x is 0. It is found by eliminating x, mx, m^2x, ... until one of them depends on
+// the ones before it. m must be smaller than 64 bits so the polynomial fits in a uint64.
+func (m Matrix) MinPoly(x uint64) (poly uint64) {
+	// basis[b] has its highest set bit at b, combos[b] records which powers of m it is made from
+	basis := make([]uint64, m.Size())
+	combos := make([]uint64, m.Size())
+
+	v := x
+	for i := 0; ; i++ {
+		reduced, combo := v, uint64(1)<<i
+		for reduced != 0 {
+			b := bits.Len64(reduced) - 1
+			if basis[b] == 0 {
+				break
+			}
+
+			reduced ^= basis[b]
+			combo ^= combos[b]
+		}
+
+		if reduced == 0 {
+			return combo
+		}
+
+		b := bits.Len64(reduced) - 1
+		basis[b], combos[b] = reduced, combo
+		v = m.Apply(v)
+	}
+}
+
+// PolyMulMod returns a times b modulo poly, all of them polynomials over GF(2) with bit i holding the
+// coefficient of t^i. a and b must already be reduced and poly must have degree below 64.
+func PolyMulMod(a uint64, b uint64, poly uint64) (product uint64) {
+	top := uint64(1) << (bits.Len64(poly) - 1)
+
+	for ; a != 0; a >>= 1 {
+		if a&1 == 1 {
+			product ^= b
+		}
+
+		b <<= 1
+		if b&top != 0 {
+			b ^= poly
+		}
+	}
+
+	return product
+}
+
+// PolyPowMod returns a to the k-th power modulo poly, see PolyMulMod
+func PolyPowMod(a uint64, k uint64, poly uint64) (power uint64) {
+	if poly == 1 {
+		return 0 // everything is 0 modulo a constant
+	}
+
+	degree := bits.Len64(poly) - 1
+	for bits.Len64(a)-1 >= degree {
+		a ^= poly << (bits.Len64(a) - 1 - degree)
+	}
+
+	power = 1
+
+	for ; k != 0; k >>= 1 {
+		if k&1 == 1 {
+			power = PolyMulMod(power, a, poly)
+		}
+		a = PolyMulMod(a, a, poly)
+	}
+
+	return power
+}
+
+// PrimeFactors returns the distinct prime factors of n in ascending order. It uses trial division,
+// which is plenty for the 2^n-1 cycle lengths of registers up to 32 bits.
+func PrimeFactors(n uint64) (factors []uint64) {
+	factors = []uint64{}
+
+	for p := uint64(2); p <= n/p; p++ {
+		if n%p != 0 {
+			continue
+		}
+
+		factors = append(factors, p)
+		for n%p == 0 {
+			n /= p
+		}
+	}
+
+	if n > 1 {
+		factors = append(factors, n)
+	}
+
+	return factors
+}
+
+// Equal reports whether two matrices are the same
+func (m Matrix) Equal(o Matrix) bool {
+	if len(m.cols) != len(o.cols) {
+		return false
+	}
+
+	for i := range m.cols {
+		if m.cols[i] != o.cols[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Table applies a Matrix a byte at a time, trading 2 KiB per byte of register for speed
+type Table [][256]uint64
+
+// Table tabulates the matrix
+func (m Matrix) Table() (t Table) {
+	mask := ^uint64(0) >> (64 - m.Size())
+
+	t = make(Table, (m.Size()+7)/8)
+	for b := range t {
+		for v := range 256 {
+			t[b][v] = m.Apply(uint64(v) << (8 * b) & mask)
+		}
+	}
+
+	return t
+}
+
+// Apply multiplies the tabulated matrix by a register
+func (t Table) Apply(x uint64) (y uint64) {
+	for b := range t {
+		y ^= t[b][byte(x>>(8*b))]
+	}
+
+	return y
+}
@@ -0,0 +1,120 @@
+package gf2
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// shift8 and subShift8 are sslfsr.Shift8Bits and sslfsr.SubShift8Bits, which import this package
+
+func shift8(x uint64) uint64 {
+	return x>>1 | uint64(bits.OnesCount64(x&0b00011101)%2)<<7
+}
+
+func subShift8(x uint64) uint64 {
+	return x&0xF0 | (x&0x0F)>>1 | uint64(bits.OnesCount64(x&0b00000011)%2)<<3
+}
+
+func TestFromFuncMatchesFunc(t *testing.T) {
+	t.Parallel()
+
+	shift := FromFunc(8, shift8)
+	sub := FromFunc(8, subShift8)
+
+	for x := range uint64(256) {
+		assert.Equal(t, shift8(x), shift.Apply(x))
+		assert.Equal(t, subShift8(x), sub.Apply(x))
+		assert.Equal(t, x, Identity(8).Apply(x))
+	}
+}
+
+func TestPow(t *testing.T) {
+	t.Parallel()
+
+	shift := FromFunc(8, shift8)
+	interval := FromFunc(8, subShift8).Mul(shift.Pow(11))
+
+	for x := range uint64(256) {
+		y := x
+		for range 11 {
+			y = shift8(y)
+		}
+		y = subShift8(y)
+
+		assert.Equal(t, y, interval.Apply(x))
+	}
+
+	assert.True(t, shift.Pow(255).Equal(Identity(8)), "the 8 bit shift has period 255")
+	assert.False(t, shift.Pow(85).Equal(Identity(8)))
+	assert.True(t, shift.Pow(0).Equal(Identity(8)))
+}
+
+func TestTable(t *testing.T) {
+	t.Parallel()
+
+	odd := FromFunc(12, func(x uint64) uint64 {
+		return (x<<3 | x>>9) & 0xFFF // rotate 12 bits
+	})
+	table := odd.Table()
+
+	assert.Len(t, table, 2)
+	for x := range uint64(1 << 12) {
+		assert.Equal(t, odd.Apply(x), table.Apply(x))
+	}
+}
+
+func TestPrimeFactors(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []uint64{3, 5}, PrimeFactors(15))
+	assert.Equal(t, []uint64{3, 5, 17, 257}, PrimeFactors(65535))
+	assert.Equal(t, []uint64{3, 5, 17, 257, 65537}, PrimeFactors(1<<32-1))
+	assert.Equal(t, []uint64{2, 3}, PrimeFactors(72))
+	assert.Equal(t, []uint64{127}, PrimeFactors(127))
+	assert.Empty(t, PrimeFactors(1))
+}
+
+func TestCycleIs(t *testing.T) {
+	t.Parallel()
+
+	shift := FromFunc(8, shift8)
+	assert.True(t, shift.CycleIs(1, 255), "the 8 bit shift is maximal")
+	assert.False(t, shift.CycleIs(1, 85), "a factor of the cycle length isn't enough")
+	assert.False(t, shift.CycleIs(1, 510), "neither is a multiple")
+	assert.True(t, Identity(8).CycleIs(3, 1))
+	assert.True(t, shift.CycleIs(0, 1))
+
+	for interval := range uint64(255) {
+		m := FromFunc(8, subShift8).Mul(shift.Pow(interval))
+
+		length := uint64(1)
+		for x := m.Apply(1); x != 1; x = m.Apply(x) {
+			length++
+		}
+
+		assert.True(t, m.CycleIs(1, length), "interval %d", interval)
+		assert.Equal(t, length == 255, m.CycleIs(1, 255), "interval %d", interval)
+	}
+}
+
+func TestMinPoly(t *testing.T) {
+	t.Parallel()
+
+	// the 8 bit shift's characteristic polynomial is t^8 plus its taps, t^8+t^4+t^3+t^2+1
+	assert.Equal(t, uint64(0b1_0001_1101), FromFunc(8, shift8).MinPoly(1))
+	assert.Equal(t, uint64(0b11), Identity(8).MinPoly(5), "t+1, every register is fixed")
+	assert.Equal(t, uint64(1), Identity(8).MinPoly(0))
+}
+
+func TestPolyPowMod(t *testing.T) {
+	t.Parallel()
+
+	poly := uint64(0b1_0001_1101)
+	assert.Equal(t, uint64(1), PolyPowMod(2, 255, poly))
+	assert.Equal(t, uint64(0b11101), PolyPowMod(2, 8, poly), "t^8 = t^4+t^3+t^2+1")
+	assert.Equal(t, uint64(1), PolyPowMod(2, 0, poly))
+	assert.Equal(t, uint64(1), PolyPowMod(2, 7, 0b11), "t = 1 modulo t+1")
+	assert.Equal(t, uint64(0b110), PolyMulMod(0b11, 0b10, poly))
+}
@@ -0,0 +1,27 @@
+// Package intervalgen writes the Go source of an Intervals<N>Bits function, the way the library
+// lists the known optimum intervals for each register width
+package intervalgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+)
+
+// Source returns a formatted Go file in package sslfsr declaring Intervals<width>Bits, which returns
+// intervals. command is recorded in the generated code header.
+func Source(width int, intervals []int, command string) (src []byte, err error) {
+	buf := &bytes.Buffer{}
+
+	fmt.Fprintf(buf, "// Code generated by %q; DO NOT EDIT.\n\n", command)
+	fmt.Fprintf(buf, "package sslfsr\n\n")
+	fmt.Fprintf(buf, "// Intervals%dBits returns a list of known optimum intervals\n", width)
+	fmt.Fprintf(buf, "func Intervals%dBits() (working []int) {\n", width)
+	fmt.Fprintf(buf, "\treturn []int{\n")
+	for _, interval := range intervals {
+		fmt.Fprintf(buf, "\t\t%d,\n", interval)
+	}
+	fmt.Fprintf(buf, "\t}\n}\n")
+
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,37 @@
+package intervalgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSource(t *testing.T) {
+	t.Parallel()
+
+	src, err := Source(5, []int{1, 3, 7}, "solver --width 5")
+	require.NoError(t, err)
+
+	assert.Equal(t, `// Code generated by "solver --width 5"; DO NOT EDIT.
+
+package sslfsr
+
+// Intervals5Bits returns a list of known optimum intervals
+func Intervals5Bits() (working []int) {
+	return []int{
+		1,
+		3,
+		7,
+	}
+}
+`, string(src))
+}
+
+func TestSourceEmpty(t *testing.T) {
+	t.Parallel()
+
+	src, err := Source(3, []int{}, "solver --width 3")
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func Intervals3Bits() (working []int) {\n\treturn []int{}\n}\n")
+}
@@ -0,0 +1,159 @@
+package solver
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/coreyog/sslfsr"
+)
+
+// ClockingWidths lists the register widths clocking can be searched for
+var ClockingWidths = []int{4, 8}
+
+// ClockingResult is the cycle structure of a clocked register whose trigger picks out the registers
+// with value under mask, see sslfsr.MaskTrigger8Bits
+type ClockingResult struct {
+	Mask  uint64 `json:"mask"`
+	Value uint64 `json:"value"`
+	sslfsr.ClockingAnalysis
+}
+
+// AnalyzeTrigger measures a clocked register of the given width whose trigger is a mask and value
+func AnalyzeTrigger(width int, mask uint64, value uint64) (result ClockingResult, err error) {
+	result = ClockingResult{Mask: mask, Value: value}
+
+	switch width {
+	case 4:
+		result.ClockingAnalysis = sslfsr.AnalyzeClocking4Bits(sslfsr.MaskTrigger4Bits(uint8(mask), uint8(value)))
+	case 8:
+		result.ClockingAnalysis = sslfsr.AnalyzeClocking8Bits(sslfsr.MaskTrigger8Bits(uint8(mask), uint8(value)))
+	default:
+		return result, fmt.Errorf("no clocked %d bit registers", width)
+	}
+
+	return result, nil
+}
+
+// SearchClocking measures every mask trigger of a width bit clocked register, each mask with every
+// value made of its bits, longest period first. A control bit is a mask of one bit. The whole
+// register against 0 is left out, it only picks out register 0 so it never SubShifts.
+func SearchClocking(width int) (results []ClockingResult, err error) {
+	results = []ClockingResult{}
+	whole := uint64(1)<<width - 1
+
+	for mask := uint64(1); mask <= whole; mask++ {
+		// every subset of mask, down to 0
+		for value := mask; ; value = (value - 1) & mask {
+			if mask != whole || value != 0 {
+				result, err := AnalyzeTrigger(width, mask, value)
+				if err != nil {
+					return nil, err
+				}
+
+				results = append(results, result)
+			}
+
+			if value == 0 {
+				break
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		switch {
+		case a.Period != b.Period:
+			return a.Period > b.Period
+		case a.Tail != b.Tail:
+			return a.Tail < b.Tail
+		case a.Mask != b.Mask:
+			return a.Mask < b.Mask
+		default:
+			return a.Value < b.Value
+		}
+	})
+
+	return results, nil
+}
+
+// ClockingFormats lists every output format a ClockingReport can be written in
+var ClockingFormats = []string{FormatText, FormatJSON, FormatCSV}
+
+// ClockingReport is everything a clocking search produces
+type ClockingReport struct {
+	Width        int              `json:"width"`
+	Triggers     int              `json:"triggers"`
+	Permutations int              `json:"permutations"` // triggers leaving every register on a cycle
+	Maximal      int              `json:"maximal"`      // triggers taking register 1 through every non-zero register
+	Results      []ClockingResult `json:"results"`
+}
+
+// NewClockingReport summarizes the results of SearchClocking
+func NewClockingReport(width int, results []ClockingResult) (report ClockingReport) {
+	report = ClockingReport{Width: width, Triggers: len(results), Results: results}
+
+	for _, r := range results {
+		if r.Permutation {
+			report.Permutations++
+		}
+
+		if r.Maximal() {
+			report.Maximal++
+		}
+	}
+
+	return report
+}
+
+// Write encodes the report to out in the given format, text only lists the top results
+func (report ClockingReport) Write(out io.Writer, format string, top int) (err error) {
+	return writeFormat(out, format, top, report, report.writeText, report.writeCSV)
+}
+
+func (report ClockingReport) writeText(out io.Writer, top int) (err error) {
+	bufout := bufio.NewWriter(out)
+
+	_, _ = bufout.WriteString(fmt.Sprintf("measured %d triggers for %d bit registers\n", report.Triggers, report.Width))
+	_, _ = bufout.WriteString(fmt.Sprintf("permutations: %d\n", report.Permutations))
+	_, _ = bufout.WriteString(fmt.Sprintf("maximal: %d\n", report.Maximal))
+
+	_, _ = bufout.WriteString(fmt.Sprintf("\n%-*s  %-*s  %6s  %4s  cycles\n", report.Width+2, "mask", report.Width+2, "value", "period", "tail"))
+	for _, r := range report.Results[:min(top, len(report.Results))] {
+		_, _ = bufout.WriteString(fmt.Sprintf("0b%0*b  0b%0*b  %6d  %4d  %v\n", report.Width, r.Mask, report.Width, r.Value, r.Period, r.Tail, r.Cycles))
+	}
+
+	return bufout.Flush()
+}
+
+var clockingCSVHeader = []string{"width", "mask", "value", "permutation", "period", "tail", "cycles"}
+
+func (report ClockingReport) writeCSV(out io.Writer) (err error) {
+	w := csv.NewWriter(out)
+
+	_ = w.Write(clockingCSVHeader)
+	for _, r := range report.Results {
+		cycles := make([]string, len(r.Cycles))
+		for i, cycle := range r.Cycles {
+			cycles[i] = strconv.Itoa(cycle)
+		}
+
+		_ = w.Write([]string{
+			strconv.Itoa(report.Width),
+			strconv.FormatUint(r.Mask, 10),
+			strconv.FormatUint(r.Value, 10),
+			strconv.FormatBool(r.Permutation),
+			strconv.Itoa(r.Period),
+			strconv.Itoa(r.Tail),
+			strings.Join(cycles, " "),
+		})
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
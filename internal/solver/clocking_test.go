@@ -0,0 +1,51 @@
+package solver
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchClocking(t *testing.T) {
+	t.Parallel()
+
+	results, err := SearchClocking(4)
+	require.NoError(t, err)
+	assert.Len(t, results, 3*3*3*3-2, "every bit is outside the mask, in it and clear, or in it and set")
+
+	for i := 1; i < len(results); i++ {
+		assert.GreaterOrEqual(t, results[i-1].Period, results[i].Period, "longest period first")
+	}
+
+	// the whole register matching 0 only ever picks out 0, which never changes either way
+	for _, result := range results {
+		assert.False(t, result.Mask == 0b1111 && result.Value == 0, "never SubShifts")
+	}
+
+	report := NewClockingReport(4, results)
+	assert.Equal(t, 79, report.Triggers)
+	assert.Positive(t, report.Maximal)
+	assert.GreaterOrEqual(t, report.Permutations, report.Maximal, "a maximal trigger is a permutation")
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, report.Write(buf, FormatCSV, 0))
+	rows, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows, 80)
+	assert.Equal(t, clockingCSVHeader, rows[0])
+}
+
+func TestAnalyzeTrigger(t *testing.T) {
+	t.Parallel()
+
+	result, err := AnalyzeTrigger(8, 0b1000_0000, 0b1000_0000)
+	require.NoError(t, err)
+	assert.Equal(t, sslfsr.AnalyzeClocking8Bits(sslfsr.ControlBitTrigger8Bits(7)), result.ClockingAnalysis)
+
+	_, err = AnalyzeTrigger(16, 1, 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+package solver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// NotifyContext returns a context cancelled by SIGINT or SIGTERM. The first signal stops the
+// notifications, so a second one kills the process outright.
+func NotifyContext() (ctx context.Context, stop context.CancelFunc) {
+	ctx, stop = signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	return ctx, stop
+}
+
+// Usage writes a problem with the command line and the usage of every flag to stderr, returning
+// ExitUsage
+func Usage(format string, args ...any) (code int) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	flag.Usage()
+
+	return ExitUsage
+}
+
+// CheckSearch checks the --workers and --progress-interval flags of a search command
+func CheckSearch(workers int, every time.Duration) (err error) {
+	switch {
+	case workers < 1:
+		return errors.New("workers must be at least 1")
+	case every <= 0:
+		return errors.New("progress-interval must be positive")
+	}
+
+	return nil
+}
+
+// LogProgress returns a callback for how many configurations a search has tested, which logs the count
+// to stderr at most once every every along with args
+func LogProgress(every time.Duration, args ...any) (tested func(total int)) {
+	logger := NewLogger(os.Stderr, FormatText)
+	start := time.Now()
+	last := start
+
+	return func(total int) {
+		if time.Since(last) < every {
+			return
+		}
+		last = time.Now()
+
+		attrs := append([]any{"tested", total}, args...)
+		logger.Info("progress", append(attrs, "elapsed", time.Since(start).Round(time.Second).String())...)
+	}
+}
+
+// SearchReport is the report of a search command, see FinishSearch
+type SearchReport interface {
+	Write(out io.Writer, format string, top int) (err error)
+}
+
+// FinishSearch shows the first top results of report on stdout, writes every result to output unless
+// that's the same text, and returns the command's exit code
+func FinishSearch(report SearchReport, interrupted bool, output string, format string, top int) (code int) {
+	// the human readable report is always shown
+	_ = report.Write(os.Stdout, FormatText, top)
+
+	if output != "-" || format != FormatText {
+		err := WriteReportFile(output, format, report.Write)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unable to write %s: %s\n", output, err)
+			return ExitFailure
+		}
+	}
+
+	if interrupted {
+		return ExitInterrupted
+	}
+
+	return ExitOK
+}
+
+// WriteReportFile writes a report to the named file, or stdout for -, with write, listing every result
+// in the text format
+func WriteReportFile(name string, format string, write func(out io.Writer, format string, top int) error) (err error) {
+	if name == "-" {
+		return write(os.Stdout, format, math.MaxInt)
+	}
+
+	outfile, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	err = write(outfile, format, math.MaxInt)
+	if err != nil {
+		_ = outfile.Close()
+		return err
+	}
+
+	return outfile.Close()
+}
+
+// writeFormat encodes a search report to out in the text, JSON or CSV format, text only lists the
+// first top results
+func writeFormat(out io.Writer, format string, top int, report any, text func(io.Writer, int) error, csv func(io.Writer) error) (err error) {
+	switch format {
+	case FormatText:
+		return text(out, top)
+	case FormatJSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	case FormatCSV:
+		return csv(out)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
@@ -0,0 +1,55 @@
+package solver
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteReportFile(t *testing.T) {
+	t.Parallel()
+
+	report := ScheduleReport{Width: 4, Length: 1, Results: []ScheduleResult{{Schedule: []int{1}, Period: 30}, {Schedule: []int{7}, Period: 120}}}
+
+	for _, format := range ScheduleFormats {
+		name := filepath.Join(t.TempDir(), "report."+format)
+		require.NoError(t, WriteReportFile(name, format, report.Write))
+
+		expected := &bytes.Buffer{}
+		require.NoError(t, report.Write(expected, format, len(report.Results)))
+
+		written, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected.String(), string(written), format)
+	}
+
+	assert.Error(t, WriteReportFile(filepath.Join(t.TempDir(), "report.xml"), "xml", report.Write))
+	assert.Error(t, WriteReportFile(filepath.Join(t.TempDir(), "missing", "report.csv"), FormatCSV, report.Write))
+}
+
+func TestCheckSearch(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, CheckSearch(1, time.Second))
+	assert.Error(t, CheckSearch(0, time.Second))
+	assert.Error(t, CheckSearch(1, 0))
+}
+
+func TestFinishSearch(t *testing.T) {
+	t.Parallel()
+
+	report := ScheduleReport{Width: 4, Length: 1, Results: []ScheduleResult{{Schedule: []int{1}, Period: 30}}}
+	name := filepath.Join(t.TempDir(), "report.json")
+
+	assert.Equal(t, ExitOK, FinishSearch(report, false, name, FormatJSON, 1))
+	assert.FileExists(t, name)
+
+	report.Interrupted = true
+	assert.Equal(t, ExitInterrupted, FinishSearch(report, report.Interrupted, name, FormatJSON, 1))
+	assert.Equal(t, ExitFailure, FinishSearch(report, report.Interrupted, filepath.Join(t.TempDir(), "missing", "report.json"), FormatJSON, 1))
+}
@@ -0,0 +1,322 @@
+package solver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Range is an inclusive range of intervals
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// Len returns the number of intervals in the range
+func (r Range) Len() int {
+	return r.End - r.Start + 1
+}
+
+// Chunks splits [start, end] into consecutive ranges of at most size intervals
+func Chunks(start int, end int, size int) (ranges []Range) {
+	for s := start; s <= end; s += size {
+		ranges = append(ranges, Range{Start: s, End: min(s+size-1, end)})
+	}
+
+	return ranges
+}
+
+// Lease hands a range of intervals to a single worker until it expires
+type Lease struct {
+	ID      int64     `json:"id"`
+	Width   int       `json:"width"`
+	Worker  string    `json:"worker"`
+	Range   Range     `json:"range"`
+	Expires time.Time `json:"expires"` // on the coordinator's clock
+	// TTL is how long the lease lasts from each heartbeat, workers time their heartbeats with it on
+	// their own clock rather than trusting Expires
+	TTL time.Duration `json:"ttl"`
+}
+
+// Completion is what a worker sends back once its lease has been solved
+type Completion struct {
+	ID      int64    `json:"id"`
+	Range   Range    `json:"range"`
+	Results []Result `json:"results"`
+}
+
+// CoordinatorStatus describes how far a distributed search has gotten
+type CoordinatorStatus struct {
+	Width    int  `json:"width"`
+	Start    int  `json:"start"`
+	End      int  `json:"end"`
+	Ranges   int  `json:"ranges"`
+	Complete int  `json:"complete"`
+	Leased   int  `json:"leased"`
+	Retries  int  `json:"retries"`
+	Done     bool `json:"done"`
+}
+
+// Coordinator hands ranges of intervals to worker processes over HTTP and merges their results.
+// Leases that aren't completed or renewed before they expire are handed to the next worker that
+// asks, so a dead worker only ever costs one lease TTL.
+//
+//	POST /lease      {"worker": name}  -> 200 Lease, 204 nothing to hand out yet, 410 search finished
+//	POST /heartbeat  {"id": id}        -> 200 Lease with a new expiry, 410 lease no longer held
+//	POST /complete   Completion        -> 200, 400 when the results don't cover the range
+//	GET  /status                       -> 200 CoordinatorStatus
+type Coordinator struct {
+	spec  Spec
+	start int
+	end   int
+	ttl   time.Duration
+	mux   *http.ServeMux
+	done  chan struct{}
+	known map[Range]bool // every range that can be leased
+
+	// Completed is called with the results of each range as it is accepted, under the coordinator's
+	// lock so calls never overlap
+	Completed func([]Result)
+
+	mu       sync.Mutex
+	ranges   int
+	pending  []Range // waiting for a worker, oldest first
+	leases   map[int64]*Lease
+	complete map[Range]bool
+	results  []Result
+	nextID   int64
+	retries  int
+}
+
+// NewCoordinator splits [start, end] into leases of chunk intervals that expire after ttl
+func NewCoordinator(spec Spec, start int, end int, chunk int, ttl time.Duration) *Coordinator {
+	c := &Coordinator{
+		spec:     spec,
+		start:    start,
+		end:      end,
+		ttl:      ttl,
+		mux:      http.NewServeMux(),
+		done:     make(chan struct{}),
+		pending:  Chunks(start, end, chunk),
+		leases:   map[int64]*Lease{},
+		complete: map[Range]bool{},
+		known:    map[Range]bool{},
+	}
+	c.ranges = len(c.pending)
+
+	for _, rng := range c.pending {
+		c.known[rng] = true
+	}
+
+	if c.ranges == 0 {
+		close(c.done)
+	}
+
+	c.mux.HandleFunc("POST /lease", c.handleLease)
+	c.mux.HandleFunc("POST /heartbeat", c.handleHeartbeat)
+	c.mux.HandleFunc("POST /complete", c.handleComplete)
+	c.mux.HandleFunc("GET /status", c.handleStatus)
+
+	return c
+}
+
+func (c *Coordinator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mux.ServeHTTP(w, r)
+}
+
+// Done is closed once every range has been completed
+func (c *Coordinator) Done() <-chan struct{} {
+	return c.done
+}
+
+// Results returns a copy of every Result received so far
+func (c *Coordinator) Results() (results []Result) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results = make([]Result, len(c.results))
+	copy(results, c.results)
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Interval < results[j].Interval
+	})
+
+	return results
+}
+
+// Status reports how far the search has gotten, expired leases are reclaimed first so they aren't
+// reported as leased
+func (c *Coordinator) Status() (status CoordinatorStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reclaim()
+
+	return CoordinatorStatus{
+		Width:    c.spec.Width,
+		Start:    c.start,
+		End:      c.end,
+		Ranges:   c.ranges,
+		Complete: len(c.complete),
+		Leased:   len(c.leases),
+		Retries:  c.retries,
+		Done:     len(c.complete) == c.ranges,
+	}
+}
+
+func (c *Coordinator) handleLease(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		Worker string `json:"worker"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reclaim()
+
+	switch {
+	case len(c.pending) > 0:
+		c.nextID++
+		lease := &Lease{
+			ID:      c.nextID,
+			Width:   c.spec.Width,
+			Worker:  req.Worker,
+			Range:   c.pending[0],
+			Expires: time.Now().Add(c.ttl),
+			TTL:     c.ttl,
+		}
+		c.pending = c.pending[1:]
+		c.leases[lease.ID] = lease
+
+		writeJSON(w, lease)
+	case len(c.complete) == c.ranges:
+		w.WriteHeader(http.StatusGone)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// reclaim puts the ranges of expired leases back in front of the queue, c.mu must be held
+func (c *Coordinator) reclaim() {
+	now := time.Now()
+
+	for id, lease := range c.leases {
+		if now.After(lease.Expires) {
+			delete(c.leases, id)
+			c.pending = append([]Range{lease.Range}, c.pending...)
+			c.retries++
+		}
+	}
+}
+
+func (c *Coordinator) handleHeartbeat(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		ID int64 `json:"id"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lease, ok := c.leases[req.ID]
+	if !ok {
+		w.WriteHeader(http.StatusGone)
+		return
+	}
+
+	lease.Expires = time.Now().Add(c.ttl)
+
+	writeJSON(w, lease)
+}
+
+func (c *Coordinator) handleComplete(w http.ResponseWriter, r *http.Request) {
+	completion := Completion{}
+	if err := json.NewDecoder(r.Body).Decode(&completion); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := c.validate(completion); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.leases, completion.ID)
+
+	// a late worker whose lease expired may still finish first, either way the first completion wins
+	if c.complete[completion.Range] {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	c.complete[completion.Range] = true
+	c.results = append(c.results, completion.Results...)
+	if c.Completed != nil {
+		c.Completed(completion.Results)
+	}
+
+	for id, lease := range c.leases {
+		if lease.Range == completion.Range {
+			delete(c.leases, id)
+		}
+	}
+
+	for i, pending := range c.pending {
+		if pending == completion.Range {
+			c.pending = append(c.pending[:i], c.pending[i+1:]...)
+			break
+		}
+	}
+
+	if len(c.complete) == c.ranges {
+		close(c.done)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// validate checks that a completion holds exactly one Result for every interval in a range the
+// coordinator handed out
+func (c *Coordinator) validate(completion Completion) (err error) {
+	rng := completion.Range
+	if !c.known[rng] {
+		return fmt.Errorf("range [%d, %d] was never leased", rng.Start, rng.End)
+	}
+
+	if len(completion.Results) != rng.Len() {
+		return fmt.Errorf("range [%d, %d] needs %d results, got %d", rng.Start, rng.End, rng.Len(), len(completion.Results))
+	}
+
+	seen := map[int]bool{}
+	for _, r := range completion.Results {
+		if r.Width != c.spec.Width || r.Interval < rng.Start || r.Interval > rng.End || seen[r.Interval] {
+			return fmt.Errorf("unexpected result for %d bit interval %d", r.Width, r.Interval)
+		}
+
+		seen[r.Interval] = true
+	}
+
+	return nil
+}
+
+func (c *Coordinator) handleStatus(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, c.Status())
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
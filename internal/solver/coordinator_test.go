@@ -0,0 +1,169 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunks(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []Range{{1, 4}, {5, 8}, {9, 10}}, Chunks(1, 10, 4))
+	assert.Equal(t, []Range{{3, 3}}, Chunks(3, 3, 4))
+	assert.Empty(t, Chunks(5, 4, 4))
+}
+
+func TestDistributedMatchesLocal(t *testing.T) {
+	t.Parallel()
+
+	coord := NewCoordinator(Spec8Bits, 1, 254, 16, 100*time.Millisecond)
+	server := httptest.NewServer(coord)
+	defer server.Close()
+
+	// a worker that takes a lease and dies without finishing it
+	resp, err := http.Post(server.URL+"/lease", "application/json", bytes.NewBufferString(`{"worker": "dead"}`))
+	require.NoError(t, err)
+	dead := Lease{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&dead))
+	resp.Body.Close()
+	assert.Equal(t, Range{1, 16}, dead.Range)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	errs := make(chan error, 2)
+	for _, name := range []string{"one", "two"} {
+		go func() {
+			errs <- Remote(ctx, Spec8Bits, testProgress{workers: 2}, RemoteOptions{
+				URL:      server.URL,
+				Name:     name,
+				Poll:     10 * time.Millisecond,
+				RetryFor: time.Second,
+			})
+		}()
+	}
+
+	select {
+	case <-coord.Done():
+	case <-ctx.Done():
+		t.Fatal("distributed search never finished")
+	}
+
+	require.NoError(t, <-errs)
+	require.NoError(t, <-errs)
+
+	status := coord.Status()
+	assert.True(t, status.Done)
+	assert.GreaterOrEqual(t, status.Retries, 1, "the dead worker's lease was handed out again")
+
+	distributed := NewReport(Spec8Bits, 1, 254, coord.Results(), 0)
+	local := NewReport(Spec8Bits, 1, 254, solve(Spec8Bits, 1, 254), 0)
+
+	assert.Equal(t, local.Summary, distributed.Summary)
+	require.Len(t, distributed.Results, len(local.Results))
+	for i := range local.Results {
+		local.Results[i].Elapsed = 0
+		distributed.Results[i].Elapsed = 0
+	}
+	assert.Equal(t, local.Results, distributed.Results)
+}
+
+func TestCoordinatorRejectsBadCompletions(t *testing.T) {
+	t.Parallel()
+
+	coord := NewCoordinator(Spec8Bits, 1, 20, 10, time.Minute)
+	server := httptest.NewServer(coord)
+	defer server.Close()
+
+	opts := RemoteOptions{URL: server.URL, Client: server.Client()}
+
+	// not a leased range
+	err := post(context.Background(), opts, "/complete", Completion{Range: Range{2, 11}}, nil)
+	assert.ErrorContains(t, err, "400")
+
+	// missing results
+	results := solve(Spec8Bits, 1, 9)
+	err = post(context.Background(), opts, "/complete", Completion{Range: Range{1, 10}, Results: results}, nil)
+	assert.ErrorContains(t, err, "400")
+
+	// duplicated results
+	results = append(results, results[0])
+	err = post(context.Background(), opts, "/complete", Completion{Range: Range{1, 10}, Results: results}, nil)
+	assert.ErrorContains(t, err, "400")
+
+	assert.Equal(t, 0, coord.Status().Complete)
+
+	// late results for a range nobody holds a lease on are still accepted
+	err = post(context.Background(), opts, "/complete", Completion{Range: Range{11, 20}, Results: solve(Spec8Bits, 11, 20)}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, coord.Status().Complete)
+
+	lease, err := remoteLease(context.Background(), opts)
+	require.NoError(t, err)
+	assert.Equal(t, Range{1, 10}, lease.Range)
+
+	lease, err = remoteLease(context.Background(), opts)
+	assert.NoError(t, err)
+	assert.Nil(t, lease, "everything is leased or complete")
+}
+
+func TestStatusReclaimsExpiredLeases(t *testing.T) {
+	t.Parallel()
+
+	coord := NewCoordinator(Spec8Bits, 1, 20, 10, 20*time.Millisecond)
+	server := httptest.NewServer(coord)
+	defer server.Close()
+
+	lease, err := remoteLease(context.Background(), RemoteOptions{URL: server.URL, Client: server.Client()})
+	require.NoError(t, err)
+	require.NotNil(t, lease)
+	assert.Equal(t, 1, coord.Status().Leased)
+
+	// the worker dies, nobody asks for work again
+	time.Sleep(50 * time.Millisecond)
+
+	status := coord.Status()
+	assert.Equal(t, 0, status.Leased)
+	assert.Equal(t, 1, status.Retries)
+}
+
+func TestHeartbeatIgnoresClockSkew(t *testing.T) {
+	t.Parallel()
+
+	// the coordinator's clock is an hour ahead of the worker's, then an hour behind
+	for _, skew := range []time.Duration{time.Hour, -time.Hour} {
+		beats := atomic.Int32{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/heartbeat" {
+				beats.Add(1)
+			}
+		}))
+
+		lease := &Lease{ID: 1, Width: 8, Range: Range{1, 2}, Expires: time.Now().Add(skew + 60*time.Millisecond), TTL: 60 * time.Millisecond}
+
+		// solving takes 5 TTLs
+		slept := false
+		progress := testProgress{workers: 1, tested: func(Result) {
+			if !slept {
+				slept = true
+				time.Sleep(300 * time.Millisecond)
+			}
+		}}
+
+		_, err := solveLease(context.Background(), Spec8Bits, progress, RemoteOptions{URL: server.URL, Client: server.Client()}, lease)
+		require.NoError(t, err)
+		server.Close()
+
+		assert.GreaterOrEqual(t, beats.Load(), int32(5), "skew %s", skew)
+		assert.LessOrEqual(t, beats.Load(), int32(30), "skew %s", skew)
+	}
+}
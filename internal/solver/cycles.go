@@ -0,0 +1,47 @@
+package solver
+
+import "math/bits"
+
+// Cycles describes how an interval map splits the non-zero registers into cycles, register 0 is
+// always a cycle of its own and isn't counted
+type Cycles struct {
+	FromOne int // length of the cycle holding register 1
+	Count   int
+	Largest int
+}
+
+// FindCycles walks every cycle of step, a permutation of the registers below states, using visited
+// to remember which registers have been seen. visited must hold a bit for every register.
+func FindCycles(states int, step func(uint64) uint64, visited Bitset) (cycles Cycles) {
+	visited.Clear()
+	visited.Set(0)
+
+	walk := func(start uint64) {
+		length := 0
+		for x := start; !visited.Test(x); x = step(x) {
+			visited.Set(x)
+			length++
+		}
+
+		cycles.Count++
+		cycles.Largest = max(cycles.Largest, length)
+		if start == 1 {
+			cycles.FromOne = length
+		}
+	}
+
+	walk(1)
+
+	for w := range visited {
+		for visited[w] != ^uint64(0) {
+			register := w*64 + bits.TrailingZeros64(^visited[w])
+			if register >= states {
+				break
+			}
+
+			walk(uint64(register))
+		}
+	}
+
+	return cycles
+}
@@ -0,0 +1,44 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindCycles(t *testing.T) {
+	t.Parallel()
+
+	visited := NewBitset(16)
+
+	// 1 -> 2 -> 1, 3 -> 3, and 4..15 in one cycle
+	swap := func(x uint64) uint64 {
+		switch {
+		case x == 1:
+			return 2
+		case x == 2:
+			return 1
+		case x == 3:
+			return 3
+		case x == 15:
+			return 4
+		default:
+			return x + 1
+		}
+	}
+
+	assert.Equal(t, Cycles{FromOne: 2, Count: 3, Largest: 12}, FindCycles(16, swap, visited))
+
+	identity := func(x uint64) uint64 { return x }
+	assert.Equal(t, Cycles{FromOne: 1, Count: 15, Largest: 1}, FindCycles(16, identity, visited), "visited is reset")
+}
+
+func TestCyclesCoverEveryRegister(t *testing.T) {
+	t.Parallel()
+
+	for _, r := range solve(Spec8Bits, 1, 254) {
+		assert.LessOrEqual(t, r.Cycles, Spec8Bits.MaxRegister(), "interval %d", r.Interval)
+		assert.GreaterOrEqual(t, r.LargestCycle, r.CycleLength, "interval %d", r.Interval)
+		assert.Equal(t, r.Optimal, r.Cycles == 1, "interval %d", r.Interval)
+	}
+}
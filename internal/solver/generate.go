@@ -0,0 +1,27 @@
+package solver
+
+import "fmt"
+
+// VerifiedIntervals returns the optimal intervals of a complete search of [1, 2^width-2] once every
+// one of them has had its full period proven, which is what makes them fit for Intervals<width>Bits
+func VerifiedIntervals(spec Spec, summary Summary) (intervals []int, err error) {
+	last := spec.MaxRegister() - 1
+
+	if summary.Interrupted || summary.Start != 1 || summary.End != last || summary.Tested != last {
+		return nil, fmt.Errorf("only a complete search of [1, %d] can be trusted", last)
+	}
+
+	for _, interval := range summary.Working {
+		if !spec.Verify(interval) {
+			return nil, fmt.Errorf("interval %d was found optimal but its full period couldn't be proven", interval)
+		}
+	}
+
+	return summary.Working, nil
+}
+
+// ProvenIntervals proves which intervals below 2^width-1 are optimal without searching any of them,
+// the same way OptimalIntervals does for tapsearch
+func ProvenIntervals(spec Spec) (intervals []int) {
+	return OptimalIntervals(spec.Width, spec.Taps, spec.SubTaps).Intervals
+}
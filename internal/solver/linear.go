@@ -0,0 +1,64 @@
+package solver
+
+import (
+	"time"
+
+	"github.com/coreyog/sslfsr/internal/gf2"
+)
+
+// maxLUTWidth is the widest register Solve builds LUTs for. A LUT holding every register is 64 MiB
+// at 24 bits but 16 GiB at 32, so wider registers are tested with a tabulated interval map and a
+// visited Bitset instead.
+const maxLUTWidth = 24
+
+// usesLUTs reports whether Solve tests this spec with a LUT per interval
+func (spec Spec) usesLUTs() bool {
+	return spec.Width <= maxLUTWidth
+}
+
+// IntervalMap returns the matrix taking a register to the register one interval later. Shift and
+// SubShift are linear so this costs the same for any interval, however wide the register.
+func (spec Spec) IntervalMap(interval int) gf2.Matrix {
+	shift := gf2.FromFunc(spec.Width, func(x uint64) uint64 {
+		return uint64(spec.Shift(uint32(x)))
+	})
+	subshift := gf2.FromFunc(spec.Width, func(x uint64) uint64 {
+		return uint64(spec.SubShift(uint32(x)))
+	})
+
+	return subshift.Mul(shift.Pow(uint64(interval)))
+}
+
+// Bitset is a compact []bool for registers too wide to track with one, 512 MiB covers 32 bits
+type Bitset []uint64
+
+// NewBitset returns a Bitset of size bits
+func NewBitset(size int) Bitset {
+	return make(Bitset, (size+63)/64)
+}
+
+// Test reports whether bit i is set
+func (b Bitset) Test(i uint64) bool {
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// Set sets bit i
+func (b Bitset) Set(i uint64) {
+	b[i/64] |= 1 << (i % 64)
+}
+
+// Clear unsets every bit
+func (b Bitset) Clear() {
+	clear(b)
+}
+
+// TestLinear walks every cycle of the tabulated interval map. visited must hold a bit for every
+// register.
+func TestLinear(spec Spec, interval int, visited Bitset) (result Result) {
+	start := time.Now()
+
+	step := spec.IntervalMap(interval).Table()
+	cycles := FindCycles(spec.States(), step.Apply, visited) // shift, shift, ..., subshift = 1 interval
+
+	return newResult(spec, interval, cycles, time.Since(start))
+}
@@ -0,0 +1,74 @@
+package solver
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinearMatchesLUTs(t *testing.T) {
+	t.Parallel()
+
+	lut := NewReport(Spec8Bits, 1, 254, solve(Spec8Bits, 1, 254), 0)
+	visited := NewBitset(Spec8Bits.States())
+
+	for _, expected := range lut.Results {
+		r := TestLinear(Spec8Bits, expected.Interval, visited)
+
+		assert.Equal(t, expected.Optimal, r.Optimal, "interval %d", r.Interval)
+		assert.Equal(t, expected.CycleLength, r.CycleLength, "interval %d", r.Interval)
+		assert.Equal(t, expected.Cycles, r.Cycles, "interval %d", r.Interval)
+		assert.Equal(t, expected.LargestCycle, r.LargestCycle, "interval %d", r.Interval)
+	}
+}
+
+func TestLinear16Bits(t *testing.T) {
+	t.Parallel()
+
+	visited := NewBitset(Spec16Bits.States())
+
+	expected := Spec16Bits.Expected()
+
+	for _, interval := range expected[:20] {
+		assert.True(t, TestLinear(Spec16Bits, interval, visited).Optimal, "interval %d", interval)
+
+		if !slices.Contains(expected, interval+1) {
+			assert.False(t, TestLinear(Spec16Bits, interval+1, visited).Optimal, "interval %d", interval+1)
+		}
+	}
+}
+
+func TestWorker(t *testing.T) {
+	t.Parallel()
+
+	todo := make(chan int, 2)
+	todo <- 11
+	todo <- 12
+	close(todo)
+
+	results := make(chan Result, 2)
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	Worker(Spec8Bits, discard{}, todo, results, wg)
+
+	assert.True(t, (<-results).Optimal)
+	assert.False(t, (<-results).Optimal)
+}
+
+func TestBitset(t *testing.T) {
+	t.Parallel()
+
+	b := NewBitset(130)
+	assert.Len(t, b, 3)
+
+	b.Set(0)
+	b.Set(129)
+	assert.True(t, b.Test(0))
+	assert.True(t, b.Test(129))
+	assert.False(t, b.Test(64))
+
+	b.Clear()
+	assert.False(t, b.Test(129))
+}
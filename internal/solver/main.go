@@ -0,0 +1,397 @@
+package solver
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/coreyog/sslfsr/internal/intervalgen"
+)
+
+// Options are the command line flags shared by every solver
+type Options struct {
+	WaitForDebugger bool
+	Format          string
+	Output          string
+	Workers         int
+	Progress        string
+	ProgressEvery   time.Duration
+	LogFormat       string
+	Serve           string
+	Join            string
+	Chunk           int
+	LeaseTTL        time.Duration
+	Shard           Shard
+	Verify          bool
+	EmitGo          string
+	Start           int
+	End             int
+}
+
+// ParseFlags parses the command line into Options, output defaults to a file named after the spec
+func ParseFlags(spec Spec) (opts Options) {
+	parsed := defineFlags()
+	flag.Parse()
+	parsed.resolve(spec)
+
+	return *parsed
+}
+
+// ParseWidthFlags parses the command line of a solver that searches any width with sslfsr.DefaultTaps
+func ParseWidthFlags() (spec Spec, opts Options) {
+	width := flag.Int("width", 12, fmt.Sprintf("register width in bits, 2 through %d", sslfsr.MaxWidth))
+	parsed := defineFlags()
+	flag.Parse()
+
+	spec, ok := WidthSpec(*width)
+	if !ok {
+		usage("width must be between 2 and %d", sslfsr.MaxWidth)
+	}
+
+	parsed.resolve(spec)
+
+	return spec, *parsed
+}
+
+// defineFlags defines every flag shared by the solvers, the defaults that depend on the Spec are
+// left zero until resolve
+func defineFlags() (opts *Options) {
+	opts = &Options{}
+
+	flag.BoolVar(&opts.WaitForDebugger, "wfd", false, "wait for a debugger to attach before solving")
+	flag.StringVar(&opts.Format, "format", FormatText, "results format: "+strings.Join(Formats, "|"))
+	flag.StringVar(&opts.Output, "output", "", "results file, - for stdout only (default \"results<width>.<format>\")")
+	flag.IntVar(&opts.Workers, "workers", 0, "number of intervals to test concurrently (default number of CPUs, at most 4 above 24 bits)")
+	flag.StringVar(&opts.Progress, "progress", ProgressAuto, "progress display: "+strings.Join(ProgressModes, "|"))
+	flag.DurationVar(&opts.ProgressEvery, "progress-interval", 10*time.Second, "how often log progress is written")
+	flag.StringVar(&opts.LogFormat, "log-format", FormatText, "log progress format: text|json")
+	flag.StringVar(&opts.Serve, "serve", "", "coordinate a distributed search from this address, e.g. :7816")
+	flag.StringVar(&opts.Join, "join", "", "work for the coordinator at this URL, e.g. http://localhost:7816")
+	flag.IntVar(&opts.Chunk, "chunk", 256, "intervals per lease when coordinating")
+	flag.DurationVar(&opts.LeaseTTL, "lease-ttl", time.Minute, "how long a worker may go silent before its lease is handed out again")
+	flag.Var(&opts.Shard, "shard", "only search the i-th of n equal slices of the intervals, e.g. 2/4")
+	flag.BoolVar(&opts.Verify, "verify", false, "prove the full period of the known intervals instead of searching")
+	flag.StringVar(&opts.EmitGo, "emit-go", "", "write the proven optimal intervals as the Go source of Intervals<width>Bits to this file")
+	flag.IntVar(&opts.Start, "start", 1, "first interval to search")
+	flag.IntVar(&opts.End, "end", 0, "last interval to search, may be beyond 2^width (default every interval below 2^width-1, the first 64 at 32 bits)")
+
+	return opts
+}
+
+// resolve fills in the defaults that depend on the spec and validates the flags
+func (opts *Options) resolve(spec Spec) {
+	if opts.Workers == 0 {
+		opts.Workers = defaultWorkers(spec)
+	}
+
+	if opts.End == 0 {
+		opts.End = spec.DefaultRange().End
+	}
+
+	switch {
+	case !slices.Contains(Formats, opts.Format):
+		usage("unknown format %q", opts.Format)
+	case opts.Workers < 1:
+		usage("workers must be at least 1")
+	case !slices.Contains(ProgressModes, opts.Progress):
+		usage("unknown progress display %q", opts.Progress)
+	case opts.ProgressEvery <= 0:
+		usage("progress-interval must be positive")
+	case opts.LogFormat != FormatText && opts.LogFormat != FormatJSON:
+		usage("unknown log format %q", opts.LogFormat)
+	case opts.Serve != "" && opts.Join != "":
+		usage("serve and join can't be used together")
+	case opts.Chunk < 1:
+		usage("chunk must be at least 1")
+	case opts.LeaseTTL <= 0:
+		usage("lease-ttl must be positive")
+	case opts.Start < 1 || opts.End < opts.Start:
+		usage("intervals must satisfy 1 <= start <= end")
+	case opts.Join != "" && opts.Shard.Count > 0:
+		usage("the coordinator decides what a worker searches, shard can't be used with join")
+	case opts.Verify && (opts.Serve != "" || opts.Join != ""):
+		usage("verify runs locally, it can't be used with serve or join")
+	case opts.Verify && spec.Expected == nil:
+		usage("there are no known %d bit intervals to verify", spec.Width)
+	case opts.EmitGo != "" && (opts.Join != "" || opts.Verify):
+		usage("emit-go needs the results of a search, it can't be used with join or verify")
+	}
+
+	if opts.Output == "" {
+		opts.Output = spec.ResultsFile(opts.Format)
+		if opts.Shard.Count > 0 {
+			ext := filepath.Ext(opts.Output)
+			opts.Output = fmt.Sprintf("%s-shard%dof%d%s", strings.TrimSuffix(opts.Output, ext), opts.Shard.Index, opts.Shard.Count, ext)
+		}
+	}
+}
+
+// defaultWorkers is a worker per CPU, but registers without LUTs need a 2^Width bit Bitset per
+// worker so those are capped at 4, which is 2 GiB at 32 bits
+func defaultWorkers(spec Spec) int {
+	if spec.usesLUTs() {
+		return runtime.NumCPU()
+	}
+
+	return min(runtime.NumCPU(), 4)
+}
+
+func usage(format string, args ...any) {
+	os.Exit(Usage(format, args...))
+}
+
+// exit codes
+const (
+	ExitOK          = 0   // every interval was tested and the results match the expected intervals
+	ExitMismatch    = 1   // every interval was tested but the results differ from the expected intervals
+	ExitUsage       = 2   // bad command line flags
+	ExitFailure     = 3   // a worker couldn't reach its coordinator
+	ExitInterrupted = 130 // SIGINT or SIGTERM stopped the search early
+)
+
+// Main is the entire body of each cmd/solver* program
+func Main(spec Spec) {
+	os.Exit(run(spec, ParseFlags(spec)))
+}
+
+// MainWidth is the entire body of cmd/solver, which searches the width given by --width
+func MainWidth() {
+	spec, opts := ParseWidthFlags()
+	os.Exit(run(spec, opts))
+}
+
+func run(spec Spec, opts Options) (code int) {
+	if opts.WaitForDebugger {
+		fmt.Println("waiting for debugger...")
+		debugger := true
+		for debugger {
+			time.Sleep(100 * time.Millisecond) // breakpoint here
+		}
+	}
+
+	// time execution
+	start := time.Now()
+	defer func() {
+		fmt.Println()
+		fmt.Printf("DONE: %s\n", time.Since(start))
+	}()
+
+	// prepare for interruptions, a second signal kills the process outright
+	ctx, stop := NotifyContext()
+	defer stop()
+
+	rng := opts.Shard.Range(opts.Start, opts.End)
+	first, last := rng.Start, rng.End
+	logger := NewLogger(os.Stderr, opts.LogFormat)
+
+	var results []Result
+	var stream *Stream
+	var err error
+
+	switch {
+	case opts.Verify:
+		verification := Verify(spec, first, last)
+		_ = verification.WriteText(os.Stdout)
+		return verification.ExitCode()
+	case opts.Serve != "":
+		stream, err = openStream(opts, os.Stdout)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unable to create %s: %s\n", opts.Output, err)
+			return ExitFailure
+		}
+
+		results, err = serve(ctx, spec, first, last, opts, logger, stream)
+		if err != nil && ctx.Err() == nil {
+			fmt.Fprintln(os.Stderr, err)
+			return ExitFailure
+		}
+	case opts.Join != "":
+		return join(ctx, spec, opts, logger)
+	default:
+		// prepare multiplexed logging, or periodic log lines when there's no terminal
+		progress := NewProgress(opts.Progress, opts.Workers, last-first+1, logger, opts.ProgressEvery)
+
+		// optimal results are echoed to stdout unless the terminal UI is using it
+		var echo io.Writer = os.Stdout
+		if _, tty := progress.(*statuxProgress); tty {
+			echo = nil
+		}
+
+		stream, err = openStream(opts, echo)
+		if err != nil {
+			progress.Finish()
+			fmt.Fprintf(os.Stderr, "unable to create %s: %s\n", opts.Output, err)
+			return ExitFailure
+		}
+
+		if stream != nil {
+			progress = streamProgress{Progress: progress, stream: stream}
+		}
+
+		results, err = Solve(ctx, spec, first, last, progress)
+
+		progress.Finish() // dispose of multiplex logging
+	}
+
+	fmt.Println() // easy to read output
+
+	if err != nil {
+		fmt.Printf("INTERRUPT: %s\n", time.Since(start))
+	}
+
+	report := NewReport(spec, first, last, results, time.Since(start))
+	report.Summary.Interrupted = err != nil
+
+	report = wrapup(spec, report, opts, stream)
+
+	return report.Summary.ExitCode()
+}
+
+// serve coordinates a distributed search of [first, last] until every range is complete or ctx is
+// cancelled
+func serve(ctx context.Context, spec Spec, first int, last int, opts Options, logger *slog.Logger, stream *Stream) (results []Result, err error) {
+	coord := NewCoordinator(spec, first, last, opts.Chunk, opts.LeaseTTL)
+	if stream != nil {
+		coord.Completed = stream.WriteAll
+	}
+
+	ln, err := net.Listen("tcp", opts.Serve)
+	if err != nil {
+		return nil, err
+	}
+
+	server := &http.Server{Handler: coord}
+	go func() {
+		_ = server.Serve(ln)
+	}()
+
+	logger.Info("coordinating", "addr", ln.Addr().String(), "width", spec.Width, "start", first, "end", last, "chunk", opts.Chunk)
+
+	ticker := time.NewTicker(opts.ProgressEvery)
+	defer ticker.Stop()
+
+wait:
+	for {
+		select {
+		case <-ticker.C:
+			status := coord.Status()
+			logger.Info("progress", "complete", status.Complete, "ranges", status.Ranges, "leased", status.Leased, "retries", status.Retries)
+		case <-coord.Done():
+			// idle workers poll every second, give them a chance to hear the search is finished
+			time.Sleep(linger)
+			break wait
+		case <-ctx.Done():
+			break wait
+		}
+	}
+
+	shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = server.Shutdown(shutdown)
+
+	return coord.Results(), ctx.Err()
+}
+
+// linger is how long a coordinator keeps answering workers after the search is finished
+const linger = 3 * time.Second
+
+// join works for a coordinator until it reports the search is finished
+func join(ctx context.Context, spec Spec, opts Options, logger *slog.Logger) (code int) {
+	hostname, _ := os.Hostname()
+
+	progress := NewProgress(opts.Progress, opts.Workers, 0, logger, opts.ProgressEvery)
+	err := Remote(ctx, spec, progress, RemoteOptions{
+		URL:      opts.Join,
+		Name:     fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		Poll:     time.Second,
+		RetryFor: 30 * time.Second,
+	})
+	progress.Finish()
+
+	switch {
+	case ctx.Err() != nil:
+		return ExitInterrupted
+	case err != nil:
+		fmt.Fprintln(os.Stderr, err)
+		return ExitFailure
+	default:
+		return ExitOK
+	}
+}
+
+// wrapup prints the summary and writes the results file. NDJSON results were streamed to the file as
+// they were gathered, so the summary is rebuilt from it, which is all a crashed run leaves behind.
+func wrapup(spec Spec, report Report, opts Options, stream *Stream) Report {
+	if stream != nil {
+		if err := stream.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to write %s: %s\n", opts.Output, err)
+		}
+
+		// results streamed to stdout can't be read back
+		if opts.Output != "-" {
+			streamed, err := ReadReportFile(opts.Output)
+			if err == nil {
+				summary := report.Summary
+				report = NewReport(spec, summary.Start, summary.End, streamed.Results, summary.Elapsed)
+				report.Summary.Interrupted = summary.Interrupted
+			} else {
+				fmt.Fprintf(os.Stderr, "unable to read back %s: %s\n", opts.Output, err)
+			}
+		}
+	}
+
+	// the human readable summary is always shown
+	_ = report.Summary.WriteText(os.Stdout)
+
+	if stream == nil {
+		if err := report.WriteFile(opts.Output, opts.Format); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to write %s: %s\n", opts.Output, err)
+		}
+	}
+
+	if opts.EmitGo != "" {
+		if err := emitGo(spec, report, opts.EmitGo); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to write %s: %s\n", opts.EmitGo, err)
+		}
+	}
+
+	return report
+}
+
+// openStream creates the results file when results are streamed as NDJSON, otherwise the Stream is nil
+func openStream(opts Options, echo io.Writer) (stream *Stream, err error) {
+	if opts.Format != FormatNDJSON {
+		return nil, nil
+	}
+
+	return CreateStream(opts.Output, echo)
+}
+
+// emitGo writes the optimal intervals of a complete search as the Go source of Intervals<width>Bits,
+// see VerifiedIntervals
+func emitGo(spec Spec, report Report, name string) (err error) {
+	intervals, err := VerifiedIntervals(spec, report.Summary)
+	if err != nil {
+		return err
+	}
+
+	command := strings.Join(append([]string{filepath.Base(os.Args[0])}, os.Args[1:]...), " ")
+
+	src, err := intervalgen.Source(spec.Width, intervals, command)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, src, 0o644)
+}
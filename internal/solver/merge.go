@@ -0,0 +1,238 @@
+package solver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ReadReport decodes a results file written with --format ndjson, json or csv. NDJSON and CSV files
+// hold no summary so their summary only records the width, the range their records cover and the
+// time spent testing them.
+func ReadReport(r io.Reader) (report Report, err error) {
+	buf := bufio.NewReader(r)
+
+	first, err := firstByte(buf)
+	if err != nil {
+		return report, err
+	}
+
+	if first == '{' {
+		return readJSON(buf)
+	}
+
+	rows, err := csv.NewReader(buf).ReadAll()
+	if err != nil {
+		return report, err
+	}
+
+	if len(rows) == 0 || !slices.Equal(rows[0], csvHeader) {
+		return report, fmt.Errorf("not a solver results file")
+	}
+
+	for i, row := range rows[1:] {
+		result, err := parseCSVResult(row)
+		if err != nil {
+			return report, fmt.Errorf("line %d: %w", i+2, err)
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	report.summarizeRange()
+
+	return report, nil
+}
+
+// summarizeRange fills in the width and range of a report read without a summary
+func (report *Report) summarizeRange() {
+	if len(report.Results) == 0 {
+		return
+	}
+
+	report.Summary.Width = report.Results[0].Width
+	report.Summary.Start = report.Results[0].Interval
+	report.Summary.End = report.Results[0].Interval
+
+	for _, r := range report.Results {
+		report.Summary.Start = min(report.Summary.Start, r.Interval)
+		report.Summary.End = max(report.Summary.End, r.Interval)
+		report.Summary.Elapsed += r.Elapsed
+	}
+}
+
+// readJSON reads a JSON report, or NDJSON with a Result on each line. A truncated last line is what
+// a crash mid-write leaves behind and is ignored so partial runs are still usable.
+func readJSON(r io.Reader) (report Report, err error) {
+	dec := json.NewDecoder(r)
+
+	first := json.RawMessage{}
+	if err := dec.Decode(&first); err != nil {
+		return report, err
+	}
+
+	probe := struct {
+		Results json.RawMessage `json:"results"`
+	}{}
+	if err := json.Unmarshal(first, &probe); err != nil {
+		return report, err
+	}
+
+	if probe.Results != nil {
+		err = json.Unmarshal(first, &report)
+		return report, err
+	}
+
+	result := Result{}
+	if err := json.Unmarshal(first, &result); err != nil {
+		return report, err
+	}
+	report.Results = []Result{result}
+
+	for {
+		result := Result{}
+		err := dec.Decode(&result)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return report, fmt.Errorf("result %d: %w", len(report.Results)+1, err)
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	report.summarizeRange()
+
+	return report, nil
+}
+
+// firstByte peeks at the first non-whitespace byte
+func firstByte(buf *bufio.Reader) (b byte, err error) {
+	for {
+		b, err = buf.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+
+		if !bytes.ContainsRune([]byte(" \t\r\n"), rune(b)) {
+			return b, buf.UnreadByte()
+		}
+	}
+}
+
+func parseCSVResult(row []string) (result Result, err error) {
+	if len(row) != len(csvHeader) {
+		return result, fmt.Errorf("expected %d columns, got %d", len(csvHeader), len(row))
+	}
+
+	ints := make([]int64, len(row))
+	for i, field := range row {
+		if csvHeader[i] == "optimal" {
+			result.Optimal, err = strconv.ParseBool(field)
+		} else {
+			ints[i], err = strconv.ParseInt(field, 10, 64)
+		}
+
+		if err != nil {
+			return result, fmt.Errorf("%s: %w", csvHeader[i], err)
+		}
+	}
+
+	result.Width = int(ints[0])
+	result.Taps = uint64(ints[1])
+	result.SubTaps = uint64(ints[2])
+	result.Interval = int(ints[3])
+	result.CycleLength = int(ints[5])
+	result.Period = int(ints[6])
+	result.Cycles = int(ints[7])
+	result.LargestCycle = int(ints[8])
+	result.Elapsed = time.Duration(ints[9])
+
+	return result, nil
+}
+
+// Coverage describes how well a set of reports covers an interval range
+type Coverage struct {
+	Gaps      []Range `json:"gaps"`      // intervals no report tested
+	Overlaps  []Range `json:"overlaps"`  // intervals more than one report tested
+	Conflicts []int   `json:"conflicts"` // intervals the reports disagree about
+}
+
+// Complete is true when every interval was tested and no reports disagree
+func (coverage Coverage) Complete() bool {
+	return len(coverage.Gaps) == 0 && len(coverage.Conflicts) == 0
+}
+
+// Merge combines the results of several reports of the same register into one Result per interval
+// of [start, end] and describes how they cover it. Results of another width or taps are an error,
+// results outside [start, end] are dropped.
+func Merge(reports []Report, start int, end int) (results []Result, coverage Coverage, err error) {
+	byInterval := map[int]Result{}
+	seen := map[int]int{}
+	conflicts := map[int]bool{}
+	var first *Result
+
+	for _, report := range reports {
+		for _, r := range report.Results {
+			if first == nil {
+				first = &r
+			}
+
+			if r.Width != first.Width || r.Taps != first.Taps || r.SubTaps != first.SubTaps {
+				return nil, coverage, fmt.Errorf("can't merge %d bit results with taps %#x and %#x with %d bit results with taps %#x and %#x",
+					r.Width, r.Taps, r.SubTaps, first.Width, first.Taps, first.SubTaps)
+			}
+
+			if r.Interval < start || r.Interval > end {
+				continue
+			}
+
+			if prev, ok := byInterval[r.Interval]; ok {
+				if prev.Optimal != r.Optimal || prev.CycleLength != r.CycleLength {
+					conflicts[r.Interval] = true
+				}
+			} else {
+				byInterval[r.Interval] = r
+				results = append(results, r)
+			}
+
+			seen[r.Interval]++
+		}
+	}
+
+	coverage.Gaps = collapse(start, end, func(interval int) bool { return seen[interval] == 0 })
+	coverage.Overlaps = collapse(start, end, func(interval int) bool { return seen[interval] > 1 })
+
+	for interval := range conflicts {
+		coverage.Conflicts = append(coverage.Conflicts, interval)
+	}
+	sort.Ints(coverage.Conflicts)
+
+	return results, coverage, nil
+}
+
+// collapse returns the runs of intervals in [start, end] that match
+func collapse(start int, end int, match func(int) bool) (ranges []Range) {
+	for interval := start; interval <= end; interval++ {
+		if !match(interval) {
+			continue
+		}
+
+		if n := len(ranges); n > 0 && ranges[n-1].End == interval-1 {
+			ranges[n-1].End = interval
+		} else {
+			ranges = append(ranges, Range{Start: interval, End: interval})
+		}
+	}
+
+	return ranges
+}
@@ -0,0 +1,102 @@
+package solver
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shardReports solves each of count shards of the 8 bit intervals and round trips them through format
+func shardReports(t *testing.T, count int, format string) (reports []Report) {
+	for i := 1; i <= count; i++ {
+		rng := Shard{Index: i, Count: count}.Range(1, 254)
+		report := NewReport(Spec8Bits, rng.Start, rng.End, solve(Spec8Bits, rng.Start, rng.End), 0)
+		assert.True(t, report.Summary.Matches, "shard %d/%d", i, count)
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, report.Write(buf, format))
+
+		decoded, err := ReadReport(buf)
+		require.NoError(t, err)
+		reports = append(reports, decoded)
+	}
+
+	return reports
+}
+
+func TestMergeShards(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{FormatJSON, FormatCSV} {
+		reports := shardReports(t, 3, format)
+
+		results, coverage, err := Merge(reports, 1, 254)
+		require.NoError(t, err)
+		assert.True(t, coverage.Complete(), format)
+		assert.Empty(t, coverage.Overlaps)
+
+		merged := NewReport(Spec8Bits, 1, 254, results, 0)
+		assert.True(t, merged.Summary.Matches, format)
+		assert.Equal(t, 254, merged.Summary.Tested)
+	}
+}
+
+func TestMergeCoverage(t *testing.T) {
+	t.Parallel()
+
+	reports := shardReports(t, 4, FormatJSON)
+
+	// lose the second shard and run the third twice
+	reports = []Report{reports[0], reports[2], reports[2], reports[3]}
+
+	results, coverage, err := Merge(reports, 1, 254)
+	require.NoError(t, err)
+	assert.False(t, coverage.Complete())
+	assert.Equal(t, []Range{{64, 127}}, coverage.Gaps)
+	assert.Equal(t, []Range{{128, 190}}, coverage.Overlaps)
+	assert.Empty(t, coverage.Conflicts)
+	assert.False(t, NewReport(Spec8Bits, 1, 254, results, 0).Summary.Matches)
+
+	// the same interval with a different outcome is a conflict
+	liar := Report{Results: []Result{reports[1].Results[0]}}
+	liar.Results[0].Optimal = !liar.Results[0].Optimal
+	_, coverage, err = Merge(append(reports, liar), 1, 254)
+	require.NoError(t, err)
+	assert.Equal(t, []int{128}, coverage.Conflicts)
+
+	wide := Report{Results: []Result{{Width: 16, Interval: 300}}}
+	_, _, err = Merge(append(reports, wide), 1, 254)
+	assert.Error(t, err)
+
+	retapped := Report{Results: []Result{reports[1].Results[0]}}
+	retapped.Results[0].SubTaps++
+	_, _, err = Merge(append(reports, retapped), 1, 254)
+	assert.Error(t, err)
+}
+
+func TestMergeSkipsEmptyAndOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	reports := shardReports(t, 2, FormatJSON)
+
+	// an interrupted shard that wrote nothing, merged first
+	results, coverage, err := Merge([]Report{{}, reports[0], reports[1]}, 100, 200)
+	require.NoError(t, err)
+	assert.True(t, coverage.Complete())
+	assert.Len(t, results, 101)
+	for _, r := range results {
+		assert.True(t, r.Interval >= 100 && r.Interval <= 200, "interval %d", r.Interval)
+	}
+}
+
+func TestReadReportRejectsText(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, NewReport(Spec8Bits, 1, 254, nil, 0).Write(buf, FormatText))
+
+	_, err := ReadReport(buf)
+	assert.Error(t, err)
+}
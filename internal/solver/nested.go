@@ -0,0 +1,277 @@
+package solver
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/coreyog/sslfsr/internal/gf2"
+)
+
+// NestedSpace is the part of a NestedSSLFSR's parameter space a search covers
+type NestedSpace struct {
+	Width       int    `json:"width"`
+	Levels      int    `json:"levels"`
+	MaxInterval uint64 `json:"max_interval"` // intervals are searched from 1 to this at every level
+}
+
+// Validate reports whether the space describes NestedSSLFSRs that can be built
+func (space NestedSpace) Validate() (err error) {
+	switch {
+	case space.Width < 2 || space.Width > sslfsr.MaxWidth:
+		return fmt.Errorf("width must be between 2 and %d bits, not %d", sslfsr.MaxWidth, space.Width)
+	case space.Levels < 1:
+		return fmt.Errorf("at least one level is needed")
+	case space.Width>>space.Levels < 2:
+		return fmt.Errorf("%d levels halve a %d bit register below 2 bits", space.Levels, space.Width)
+	case space.MaxInterval < 1:
+		return fmt.Errorf("max interval must be at least 1")
+	default:
+		return nil
+	}
+}
+
+// MaxShifts is the most SubShifts worth applying at a level, its sub-register comes back around after
+// one more
+func (space NestedSpace) MaxShifts(level int) int {
+	return 1<<(space.Width>>level) - 2
+}
+
+// Configurations returns how many configurations the space holds
+func (space NestedSpace) Configurations() (count int) {
+	count = 1
+	for level := 1; level <= space.Levels; level++ {
+		count *= int(space.MaxInterval) * space.MaxShifts(level)
+	}
+
+	return count
+}
+
+// NestedResult records a NestedSSLFSR configuration with a maximal period
+type NestedResult struct {
+	Levels []sslfsr.NestedLevel `json:"levels"`
+	Period uint64               `json:"period"`
+}
+
+// nestedSearch holds the maps every configuration is built from
+type nestedSearch struct {
+	space     NestedSpace
+	shift     gf2.Matrix
+	subshifts []gf2.Matrix // subshifts[level] SubShifts that level's sub-register once
+	cycle     uint64
+}
+
+func newNestedSearch(space NestedSpace) (search nestedSearch) {
+	taps, _, _ := sslfsr.DefaultTaps(space.Width)
+
+	search = nestedSearch{
+		space: space,
+		shift: gf2.FromFunc(space.Width, func(register uint64) uint64 {
+			return sslfsr.ShiftWidth(register, space.Width, taps)
+		}),
+		subshifts: make([]gf2.Matrix, space.Levels+1),
+		cycle:     uint64(1)<<space.Width - 1,
+	}
+
+	for level := 1; level <= space.Levels; level++ {
+		subWidth := space.Width >> level
+		taps, _, _ := sslfsr.DefaultTaps(subWidth)
+		search.subshifts[level] = gf2.FromFunc(space.Width, func(register uint64) uint64 {
+			return sslfsr.SubShiftWidth(register, subWidth, taps)
+		})
+	}
+
+	return search
+}
+
+// search tests every configuration whose first level has the given interval. A pass of level k is
+// SubShifts after the Shifts after the passes of every level above it, the deepest first, so the map
+// before each level's SubShifts is built up from the one before the level above's.
+func (search nestedSearch) search(interval uint64, batch *nestedBatch) {
+	levels := make([]sslfsr.NestedLevel, search.space.Levels)
+	levels[0].Interval = interval
+
+	var descend func(level int, before gf2.Matrix)
+	descend = func(level int, before gf2.Matrix) {
+		subshifts := gf2.Identity(search.space.Width)
+
+		for shifts := 1; shifts <= search.space.MaxShifts(level); shifts++ {
+			subshifts = search.subshifts[level].Mul(subshifts)
+			pass := subshifts.Mul(before)
+			levels[level-1].Shifts = shifts
+
+			if level == search.space.Levels {
+				batch.tested++
+				if pass.CycleIs(1, search.cycle) {
+					reg, _ := sslfsr.NewNestedSSLFSR(search.space.Width, levels...)
+					batch.maximal = append(batch.maximal, NestedResult{
+						Levels: slices.Clone(levels),
+						Period: reg.CalculateExpectedMaximalLength(),
+					})
+				}
+
+				continue
+			}
+
+			passes := gf2.Identity(search.space.Width)
+			for next := uint64(1); next <= search.space.MaxInterval; next++ {
+				passes = passes.Mul(pass)
+				levels[level].Interval = next
+				descend(level+1, before.Mul(passes))
+			}
+		}
+	}
+
+	descend(1, search.shift.Pow(interval))
+}
+
+type nestedBatch struct {
+	tested  int
+	maximal []NestedResult
+}
+
+// SearchNested finds every NestedSSLFSR configuration in space with a maximal period. Workers take
+// the configurations with each first level interval in turn, tested is called on the calling goroutine
+// with the running total as each one finishes. Cancelling ctx stops handing out first level intervals,
+// the configurations found so far are still returned along with ctx's error.
+func SearchNested(ctx context.Context, space NestedSpace, workers int, tested func(int)) (results []NestedResult, err error) {
+	search := newNestedSearch(space)
+
+	todo := make(chan int)
+	done := make(chan nestedBatch)
+
+	go func() {
+		defer close(todo)
+
+		Intervals(ctx, 1, int(space.MaxInterval), todo)
+	}()
+
+	wg := &sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for interval := range todo {
+				batch := nestedBatch{}
+				search.search(uint64(interval), &batch)
+				done <- batch
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	results = []NestedResult{}
+	total := 0
+	for batch := range done {
+		results = append(results, batch.maximal...)
+		total += batch.tested
+		if tested != nil {
+			tested(total)
+		}
+	}
+
+	slices.SortFunc(results, func(a, b NestedResult) int {
+		for i := range a.Levels {
+			if c := cmp.Compare(a.Levels[i].Interval, b.Levels[i].Interval); c != 0 {
+				return c
+			}
+			if c := cmp.Compare(a.Levels[i].Shifts, b.Levels[i].Shifts); c != 0 {
+				return c
+			}
+		}
+
+		return 0
+	})
+
+	return results, ctx.Err()
+}
+
+// NestedFormats lists every output format a NestedReport can be written in
+var NestedFormats = []string{FormatText, FormatJSON, FormatCSV}
+
+// NestedReport is everything a nested search produces
+type NestedReport struct {
+	NestedSpace
+	Configurations int            `json:"configurations"`
+	Tested         int            `json:"tested"` // configurations tested before any interruption
+	Maximal        int            `json:"maximal"`
+	Results        []NestedResult `json:"results"` // every maximal configuration
+	Interrupted    bool           `json:"interrupted"`
+}
+
+// NewNestedReport summarizes the results of SearchNested
+func NewNestedReport(space NestedSpace, tested int, results []NestedResult) (report NestedReport) {
+	return NestedReport{
+		NestedSpace:    space,
+		Configurations: space.Configurations(),
+		Tested:         tested,
+		Maximal:        len(results),
+		Results:        results,
+	}
+}
+
+// Write encodes the report to out in the given format, text only lists the first top configurations
+func (report NestedReport) Write(out io.Writer, format string, top int) (err error) {
+	return writeFormat(out, format, top, report, report.writeText, report.writeCSV)
+}
+
+func (report NestedReport) writeText(out io.Writer, top int) (err error) {
+	bufout := bufio.NewWriter(out)
+
+	_, _ = bufout.WriteString(fmt.Sprintf("tested %d of %d configurations of %d levels for %d bit registers\n", report.Tested, report.Configurations, report.Levels, report.Width))
+	_, _ = bufout.WriteString(fmt.Sprintf("maximal configurations: %d\n", report.Maximal))
+	if report.Interrupted {
+		_, _ = bufout.WriteString("interrupted\n")
+	}
+
+	for _, r := range report.Results[:min(top, len(report.Results))] {
+		levels := make([]string, len(r.Levels))
+		for i, level := range r.Levels {
+			levels[i] = fmt.Sprintf("interval %d shifts %d", level.Interval, level.Shifts)
+		}
+
+		_, _ = bufout.WriteString(fmt.Sprintf("%s  period %d\n", strings.Join(levels, ", "), r.Period))
+	}
+
+	return bufout.Flush()
+}
+
+var nestedCSVHeader = []string{"width", "intervals", "shifts", "period"}
+
+func (report NestedReport) writeCSV(out io.Writer) (err error) {
+	w := csv.NewWriter(out)
+
+	_ = w.Write(nestedCSVHeader)
+	for _, r := range report.Results {
+		intervals := make([]string, len(r.Levels))
+		shifts := make([]string, len(r.Levels))
+		for i, level := range r.Levels {
+			intervals[i] = strconv.FormatUint(level.Interval, 10)
+			shifts[i] = strconv.Itoa(level.Shifts)
+		}
+
+		_ = w.Write([]string{
+			strconv.Itoa(report.Width),
+			strings.Join(intervals, " "),
+			strings.Join(shifts, " "),
+			strconv.FormatUint(r.Period, 10),
+		})
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
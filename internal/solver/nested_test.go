@@ -0,0 +1,87 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchNestedOneLevel(t *testing.T) {
+	t.Parallel()
+
+	space := NestedSpace{Width: 8, Levels: 1, MaxInterval: 254}
+	require.NoError(t, space.Validate())
+
+	results, err := SearchNested(context.Background(), space, 2, nil)
+	require.NoError(t, err)
+
+	// one SubShift per interval is an SSLFSR8
+	intervals := []int{}
+	for _, r := range results {
+		if r.Levels[0].Shifts == 1 {
+			intervals = append(intervals, int(r.Levels[0].Interval))
+		}
+	}
+	assert.Equal(t, sslfsr.Intervals8Bits(), intervals)
+}
+
+func TestSearchNested(t *testing.T) {
+	t.Parallel()
+
+	space := NestedSpace{Width: 8, Levels: 2, MaxInterval: 12}
+
+	tested := 0
+	results, err := SearchNested(context.Background(), space, 3, func(total int) { tested = total })
+	require.NoError(t, err)
+	assert.Equal(t, space.Configurations(), tested)
+	assert.Equal(t, 12*14*12*2, tested)
+	require.NotEmpty(t, results)
+
+	found := map[[4]int]bool{}
+	for _, r := range results {
+		reg, err := sslfsr.NewNestedSSLFSR(8, r.Levels...)
+		require.NoError(t, err)
+		assert.True(t, reg.VerifyPeriod(), "levels %v", r.Levels)
+		assert.Equal(t, reg.CalculatePeriod(), r.Period, "levels %v", r.Levels)
+
+		found[[4]int{int(r.Levels[0].Interval), r.Levels[0].Shifts, int(r.Levels[1].Interval), r.Levels[1].Shifts}] = true
+	}
+
+	// every configuration that wasn't found isn't maximal
+	for config := range 12 * 14 * 12 * 2 {
+		levels := []sslfsr.NestedLevel{
+			{Interval: uint64(config/(14*12*2) + 1), Shifts: config/(12*2)%14 + 1},
+			{Interval: uint64(config/2%12 + 1), Shifts: config%2 + 1},
+		}
+		reg, err := sslfsr.NewNestedSSLFSR(8, levels...)
+		require.NoError(t, err)
+
+		key := [4]int{int(levels[0].Interval), levels[0].Shifts, int(levels[1].Interval), levels[1].Shifts}
+		assert.Equal(t, found[key], reg.VerifyPeriod(), "levels %v", levels)
+	}
+
+	report := NewNestedReport(space, tested, results)
+	assert.Equal(t, len(results), report.Maximal)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, report.Write(buf, FormatCSV, 0))
+	rows, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows, len(results)+1)
+	assert.Equal(t, nestedCSVHeader, rows[0])
+}
+
+func TestNestedSpaceValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, NestedSpace{Width: 16, Levels: 3, MaxInterval: 1}.Validate())
+	assert.Error(t, NestedSpace{Width: 8, Levels: 3, MaxInterval: 1}.Validate())
+	assert.Error(t, NestedSpace{Width: 8, Levels: 0, MaxInterval: 1}.Validate())
+	assert.Error(t, NestedSpace{Width: 8, Levels: 1, MaxInterval: 0}.Validate())
+	assert.Error(t, NestedSpace{Width: 64, Levels: 1, MaxInterval: 1}.Validate())
+}
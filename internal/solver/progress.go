@@ -0,0 +1,192 @@
+package solver
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreyog/statux"
+)
+
+// progress modes
+const (
+	ProgressAuto = "auto" // tty when stdout is a terminal, otherwise log
+	ProgressTTY  = "tty"  // statux terminal UI
+	ProgressLog  = "log"  // periodic structured log lines
+)
+
+// ProgressModes lists every supported progress mode
+var ProgressModes = []string{ProgressAuto, ProgressTTY, ProgressLog}
+
+// Progress displays what each worker is doing while a solver runs
+type Progress interface {
+	// Lines returns one writer per worker
+	Lines() []io.StringWriter
+	// Tested is called once for every Result the solver gathers
+	Tested(result Result)
+	// Finish disposes of the display, it is safe to call more than once
+	Finish()
+}
+
+// NewProgress builds the Progress for a mode, falling back to log output when the terminal UI
+// isn't available
+func NewProgress(mode string, workers int, total int, logger *slog.Logger, every time.Duration) (progress Progress) {
+	if mode == ProgressAuto {
+		mode = ProgressLog
+		if isTerminal(os.Stdout) {
+			mode = ProgressTTY
+		}
+	}
+
+	if mode == ProgressTTY {
+		stat, err := statux.New(workers)
+		if err == nil {
+			return newStatuxProgress(stat)
+		}
+
+		logger.Warn("terminal UI unavailable, logging progress instead", "err", err)
+	}
+
+	return newLogProgress(workers, total, logger, every)
+}
+
+// NewLogger builds the slog.Logger used for headless progress in the given format, text or json
+func NewLogger(out io.Writer, format string) (logger *slog.Logger) {
+	if format == FormatJSON {
+		return slog.New(slog.NewJSONHandler(out, nil))
+	}
+
+	return slog.New(slog.NewTextHandler(out, nil))
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// statuxProgress multiplexes worker status onto the terminal
+type statuxProgress struct {
+	stat  *statux.Statux
+	lines []io.StringWriter
+	once  sync.Once
+}
+
+func newStatuxProgress(stat *statux.Statux) *statuxProgress {
+	p := &statuxProgress{stat: stat}
+	for _, line := range stat.BuildLineWriters() {
+		p.lines = append(p.lines, line)
+	}
+
+	return p
+}
+
+func (p *statuxProgress) Lines() []io.StringWriter {
+	return p.lines
+}
+
+func (p *statuxProgress) Tested(Result) {}
+
+func (p *statuxProgress) Finish() {
+	p.once.Do(p.stat.Finish)
+}
+
+// logProgress periodically logs a single structured line describing the whole run
+type logProgress struct {
+	logger *slog.Logger
+	total  int
+	start  time.Time
+	done   chan struct{}
+	once   sync.Once
+
+	mu      sync.Mutex
+	current []string // what each worker last reported
+	tested  int
+	found   int
+}
+
+func newLogProgress(workers int, total int, logger *slog.Logger, every time.Duration) *logProgress {
+	p := &logProgress{
+		logger:  logger,
+		total:   total,
+		start:   time.Now(),
+		done:    make(chan struct{}),
+		current: make([]string, workers),
+	}
+
+	go func() {
+		ticker := time.NewTicker(every)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				p.log("progress")
+			case <-p.done:
+				return
+			}
+		}
+	}()
+
+	return p
+}
+
+func (p *logProgress) Lines() []io.StringWriter {
+	lines := make([]io.StringWriter, len(p.current))
+	for i := range lines {
+		lines[i] = &logLine{progress: p, index: i}
+	}
+
+	return lines
+}
+
+func (p *logProgress) Tested(result Result) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tested++
+	if result.Optimal {
+		p.found++
+	}
+}
+
+func (p *logProgress) Finish() {
+	p.once.Do(func() {
+		close(p.done)
+		p.log("finished")
+	})
+}
+
+func (p *logProgress) log(msg string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.logger.Info(msg,
+		"tested", p.tested,
+		"total", p.total,
+		"found", p.found,
+		"workers", strings.Join(p.current, ","),
+		"elapsed", time.Since(p.start).Round(time.Millisecond).String(),
+	)
+}
+
+// logLine records a worker's latest status for the next progress line
+type logLine struct {
+	progress *logProgress
+	index    int
+}
+
+func (l *logLine) WriteString(s string) (n int, err error) {
+	l.progress.mu.Lock()
+	defer l.progress.mu.Unlock()
+
+	l.progress.current[l.index] = s
+
+	return len(s), nil
+}
@@ -0,0 +1,54 @@
+package solver
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogProgress(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	progress := NewProgress(ProgressLog, 2, 10, NewLogger(buf, FormatJSON), time.Hour)
+
+	lines := progress.Lines()
+	require.Len(t, lines, 2)
+
+	_, _ = lines[0].WriteString("7")
+	_, _ = lines[1].WriteString("DONE")
+	progress.Tested(Result{Interval: 7, Optimal: true})
+	progress.Tested(Result{Interval: 8})
+	progress.Finish()
+	progress.Finish()
+
+	logged := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, logged, 1, "finish only logs once")
+
+	line := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(logged[0]), &line))
+	assert.Equal(t, "finished", line["msg"])
+	assert.Equal(t, 2.0, line["tested"])
+	assert.Equal(t, 10.0, line["total"])
+	assert.Equal(t, 1.0, line["found"])
+	assert.Equal(t, "7,DONE", line["workers"])
+}
+
+func TestAutoProgressWithoutTerminal(t *testing.T) {
+	t.Parallel()
+
+	if isTerminal(os.Stdout) {
+		t.Skip("stdout is a terminal")
+	}
+
+	progress := NewProgress(ProgressAuto, 1, 1, NewLogger(&bytes.Buffer{}, FormatText), time.Hour)
+	defer progress.Finish()
+
+	assert.IsType(t, &logProgress{}, progress)
+}
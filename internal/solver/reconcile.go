@@ -0,0 +1,72 @@
+package solver
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Reconciliation lists every way a search's optimal intervals differ from the known list
+type Reconciliation struct {
+	Unexpected []int    `json:"unexpected"` // optimal but not in the known list
+	Failed     []Result `json:"failed"`     // in the known list but not optimal, with what was observed instead
+	Untested   []int    `json:"untested"`   // in the known list but never tested, e.g. after an interruption
+}
+
+// Reconcile compares results against the known optimal intervals
+func Reconcile(expected []int, results []Result) (reconciliation Reconciliation) {
+	reconciliation = Reconciliation{Unexpected: []int{}, Failed: []Result{}, Untested: []int{}}
+
+	listed := map[int]bool{}
+	for _, interval := range expected {
+		listed[interval] = true
+	}
+
+	tested := map[int]Result{}
+	for _, r := range results {
+		tested[r.Interval] = r
+
+		if r.Optimal && !listed[r.Interval] {
+			reconciliation.Unexpected = append(reconciliation.Unexpected, r.Interval)
+		}
+	}
+
+	for _, interval := range expected {
+		r, ok := tested[interval]
+		switch {
+		case !ok:
+			reconciliation.Untested = append(reconciliation.Untested, interval)
+		case !r.Optimal:
+			reconciliation.Failed = append(reconciliation.Failed, r)
+		}
+	}
+
+	slices.Sort(reconciliation.Unexpected)
+
+	return reconciliation
+}
+
+// Matches reports whether the results agreed with the known list in every respect
+func (reconciliation Reconciliation) Matches() bool {
+	return len(reconciliation.Unexpected) == 0 && len(reconciliation.Failed) == 0 && len(reconciliation.Untested) == 0
+}
+
+// WriteText lists the differences, writing nothing when there are none
+func (reconciliation Reconciliation) WriteText(out io.Writer) (err error) {
+	if len(reconciliation.Unexpected) > 0 {
+		_, _ = fmt.Fprintf(out, "optimal but not listed: %v\n", reconciliation.Unexpected)
+	}
+
+	if len(reconciliation.Failed) > 0 {
+		_, _ = fmt.Fprintf(out, "listed but not optimal:\n")
+		for _, r := range reconciliation.Failed {
+			_, _ = fmt.Fprintf(out, "  %d: register 1 cycles after %d intervals, %d cycles, the largest %d\n", r.Interval, r.CycleLength, r.Cycles, r.LargestCycle)
+		}
+	}
+
+	if len(reconciliation.Untested) > 0 {
+		_, err = fmt.Fprintf(out, "listed but not tested: %d intervals\n", len(reconciliation.Untested))
+	}
+
+	return err
+}
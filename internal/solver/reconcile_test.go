@@ -0,0 +1,49 @@
+package solver
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	results := solve(Spec8Bits, 1, 40)
+
+	// 1, 11 and 29 are optimal and 2 isn't
+	reconciliation := Reconcile([]int{2, 11, 29}, results)
+
+	assert.Equal(t, []int{1}, reconciliation.Unexpected)
+	require.Len(t, reconciliation.Failed, 1)
+	assert.Equal(t, 2, reconciliation.Failed[0].Interval)
+	assert.Equal(t, 14, reconciliation.Failed[0].CycleLength)
+	assert.Empty(t, reconciliation.Untested)
+	assert.False(t, reconciliation.Matches())
+
+	reconciliation = Reconcile([]int{1, 11, 29, 63}, results)
+	assert.Equal(t, []int{63}, reconciliation.Untested)
+	assert.False(t, reconciliation.Matches())
+
+	assert.True(t, Reconcile([]int{1, 11, 29}, results).Matches())
+}
+
+func TestReconciliationText(t *testing.T) {
+	t.Parallel()
+
+	reconciliation := Reconcile([]int{2, 11, 300}, solve(Spec8Bits, 1, 20))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, reconciliation.WriteText(buf))
+	assert.Equal(t, `optimal but not listed: [1]
+listed but not optimal:
+  2: register 1 cycles after 14 intervals, 29 cycles, the largest 14
+listed but not tested: 1 intervals
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, Reconcile([]int{1, 11}, solve(Spec8Bits, 1, 20)).WriteText(buf))
+	assert.Empty(t, buf.String())
+}
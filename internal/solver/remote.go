@@ -0,0 +1,156 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RemoteOptions configure a worker process that solves leases handed out by a Coordinator
+type RemoteOptions struct {
+	URL      string        // coordinator base URL, e.g. http://localhost:7816
+	Name     string        // identifies this worker in leases
+	Poll     time.Duration // wait between lease requests when there is nothing to hand out
+	RetryFor time.Duration // give up after the coordinator has been unreachable this long
+	Client   *http.Client
+}
+
+// errFinished is returned by lease once the coordinator reports the search is over
+var errFinished = errors.New("search finished")
+
+// Remote solves leases from the coordinator until it reports the search is finished, which returns
+// nil, or until ctx is cancelled, which abandons the current lease for another worker to pick up
+func Remote(ctx context.Context, spec Spec, progress Progress, opts RemoteOptions) (err error) {
+	opts.URL = strings.TrimSuffix(opts.URL, "/")
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+
+	unreachable := time.Time{}
+
+	for {
+		lease, err := remoteLease(ctx, opts)
+		if errors.Is(err, errFinished) {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err != nil {
+			if unreachable.IsZero() {
+				unreachable = time.Now()
+			}
+
+			if time.Since(unreachable) > opts.RetryFor {
+				return err
+			}
+		} else {
+			unreachable = time.Time{}
+		}
+
+		if lease == nil {
+			select {
+			case <-time.After(opts.Poll):
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if lease.Width != spec.Width {
+			return fmt.Errorf("coordinator is searching %d bit intervals, this solver is %d bits", lease.Width, spec.Width)
+		}
+
+		results, err := solveLease(ctx, spec, progress, opts, lease)
+		if err != nil {
+			return err
+		}
+
+		err = post(ctx, opts, "/complete", Completion{ID: lease.ID, Range: lease.Range, Results: results}, nil)
+		if err != nil && ctx.Err() == nil {
+			// the lease will expire and be handed out again
+			continue
+		}
+	}
+}
+
+// solveLease solves a lease while renewing it in the background, three times a TTL so a late
+// heartbeat or two doesn't lose it
+func solveLease(ctx context.Context, spec Spec, progress Progress, opts RemoteOptions, lease *Lease) (results []Result, err error) {
+	beat, stop := context.WithCancel(ctx)
+	defer stop()
+
+	go func() {
+		every := max(lease.TTL/3, time.Millisecond)
+		ticker := time.NewTicker(every)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				_ = post(beat, opts, "/heartbeat", map[string]int64{"id": lease.ID}, nil)
+			case <-beat.Done():
+				return
+			}
+		}
+	}()
+
+	return Solve(ctx, spec, lease.Range.Start, lease.Range.End, progress)
+}
+
+// remoteLease asks for a lease, returning a nil lease when there is nothing to hand out yet
+func remoteLease(ctx context.Context, opts RemoteOptions) (lease *Lease, err error) {
+	lease = &Lease{}
+
+	err = post(ctx, opts, "/lease", map[string]string{"worker": opts.Name}, lease)
+	if err != nil {
+		return nil, err
+	}
+
+	if lease.ID == 0 {
+		return nil, nil
+	}
+
+	return lease, nil
+}
+
+// post sends body as JSON and decodes a 200 response into out when it isn't nil
+func post(ctx context.Context, opts RemoteOptions, path string, body any, out any) (err error) {
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, opts.URL+path, bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := opts.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if out != nil {
+			return json.NewDecoder(resp.Body).Decode(out)
+		}
+		return nil
+	case http.StatusNoContent:
+		return nil
+	case http.StatusGone:
+		return errFinished
+	default:
+		return fmt.Errorf("%s: %s", path, resp.Status)
+	}
+}
@@ -0,0 +1,201 @@
+package solver
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// supported output formats
+const (
+	FormatText   = "text"
+	FormatJSON   = "json"
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson" // one Result per line, streamed as the search runs
+)
+
+// Formats lists every supported output format
+var Formats = []string{FormatText, FormatNDJSON, FormatJSON, FormatCSV}
+
+// Result records the outcome of testing a single interval
+type Result struct {
+	Width        int           `json:"width"`
+	Taps         uint64        `json:"taps"`
+	SubTaps      uint64        `json:"subtaps"`
+	Interval     int           `json:"interval"`
+	Optimal      bool          `json:"optimal"`
+	CycleLength  int           `json:"cycle_length"`  // intervals until register 1 repeats
+	Period       int           `json:"period"`        // Next() calls until register 1 repeats
+	Cycles       int           `json:"cycles"`        // cycles the interval map splits the non-zero registers into
+	LargestCycle int           `json:"largest_cycle"` // length of the longest of those cycles
+	Elapsed      time.Duration `json:"elapsed_ns"`
+}
+
+// Summary describes a whole solver run
+type Summary struct {
+	Width        int           `json:"width"`
+	Taps         uint64        `json:"taps"`
+	SubTaps      uint64        `json:"subtaps"`
+	Start        int           `json:"start"` // first tested interval
+	End          int           `json:"end"`   // last tested interval
+	Tested       int           `json:"tested"`
+	Working      []int         `json:"working"`
+	WorkingCount int           `json:"working_count"`
+	Matches      bool          `json:"matches"` // always true when no intervals are known
+	Interrupted  bool          `json:"interrupted"`
+	Elapsed      time.Duration `json:"elapsed_ns"`
+
+	Reconciliation Reconciliation `json:"reconciliation"` // how the results differ from the known intervals
+}
+
+// Report is everything a solver run produces
+type Report struct {
+	Results []Result `json:"results"`
+	Summary Summary  `json:"summary"`
+}
+
+// NewReport sorts results by interval and summarizes them against the spec's expected intervals
+// within [start, end]
+func NewReport(spec Spec, start int, end int, results []Result, elapsed time.Duration) (report Report) {
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Interval < results[j].Interval
+	})
+
+	working := []int{}
+	for _, r := range results {
+		if r.Optimal {
+			working = append(working, r.Interval)
+		}
+	}
+
+	reconciliation := Reconciliation{Unexpected: []int{}, Failed: []Result{}, Untested: []int{}}
+	if spec.Expected != nil {
+		reconciliation = Reconcile(spec.ExpectedWithin(start, end), results)
+	}
+
+	return Report{
+		Results: results,
+		Summary: Summary{
+			Width:          spec.Width,
+			Taps:           spec.Taps,
+			SubTaps:        spec.SubTaps,
+			Start:          start,
+			End:            end,
+			Tested:         len(results),
+			Working:        working,
+			WorkingCount:   len(working),
+			Matches:        reconciliation.Matches(),
+			Reconciliation: reconciliation,
+			Elapsed:        elapsed,
+		},
+	}
+}
+
+// Write encodes the report to out in the given format
+func (report Report) Write(out io.Writer, format string) (err error) {
+	switch format {
+	case FormatText:
+		return report.Summary.WriteText(out)
+	case FormatJSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	case FormatCSV:
+		return report.writeCSV(out)
+	case FormatNDJSON:
+		enc := json.NewEncoder(out)
+		for _, r := range report.Results {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// WriteFile writes the report to the named file in the given format. A name of - writes to stdout,
+// where text is skipped because solvers always print the summary there.
+func (report Report) WriteFile(name string, format string) (err error) {
+	if name == "-" {
+		if format == FormatText {
+			return nil
+		}
+
+		return report.Write(os.Stdout, format)
+	}
+
+	outfile, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	err = report.Write(outfile, format)
+	if err != nil {
+		_ = outfile.Close()
+		return err
+	}
+
+	return outfile.Close()
+}
+
+// WriteText writes the human readable summary solvers have always printed
+func (summary Summary) WriteText(out io.Writer) (err error) {
+	bufout := bufio.NewWriter(out)
+
+	_, _ = bufout.WriteString(fmt.Sprintf("tested intervals: [%d, %d]\n", summary.Start, summary.End))
+	_, _ = bufout.WriteString(fmt.Sprintf("%v\n", summary.Working))
+	_, _ = bufout.WriteString(fmt.Sprintf("working count: %d\n", summary.WorkingCount))
+	_, _ = bufout.WriteString(fmt.Sprintf("matches expected results: %t\n", summary.Matches))
+	_ = summary.Reconciliation.WriteText(bufout)
+	if summary.Interrupted {
+		_, _ = bufout.WriteString(fmt.Sprintf("interrupted after: %d of %d\n", summary.Tested, summary.End-summary.Start+1))
+	}
+
+	return bufout.Flush()
+}
+
+// ExitCode is the solver's exit code for this summary, an interrupted run is never a mismatch
+func (summary Summary) ExitCode() (code int) {
+	switch {
+	case summary.Interrupted:
+		return ExitInterrupted
+	case !summary.Matches:
+		return ExitMismatch
+	default:
+		return ExitOK
+	}
+}
+
+var csvHeader = []string{"width", "taps", "subtaps", "interval", "optimal", "cycle_length", "period", "cycles", "largest_cycle", "elapsed_ns"}
+
+func (report Report) writeCSV(out io.Writer) (err error) {
+	w := csv.NewWriter(out)
+
+	_ = w.Write(csvHeader)
+	for _, r := range report.Results {
+		_ = w.Write([]string{
+			strconv.Itoa(r.Width),
+			strconv.FormatUint(r.Taps, 10),
+			strconv.FormatUint(r.SubTaps, 10),
+			strconv.Itoa(r.Interval),
+			strconv.FormatBool(r.Optimal),
+			strconv.Itoa(r.CycleLength),
+			strconv.Itoa(r.Period),
+			strconv.Itoa(r.Cycles),
+			strconv.Itoa(r.LargestCycle),
+			strconv.FormatInt(int64(r.Elapsed), 10),
+		})
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
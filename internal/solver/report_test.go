@@ -0,0 +1,55 @@
+package solver
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportFormats(t *testing.T) {
+	t.Parallel()
+
+	results := []Result{
+		{Width: 8, Taps: 29, SubTaps: 3, Interval: 11, Optimal: true, CycleLength: 255, Period: 3060, Cycles: 1, LargestCycle: 255},
+		{Width: 8, Taps: 29, SubTaps: 3, Interval: 2, Optimal: false, CycleLength: 51, Period: 153, Cycles: 5, LargestCycle: 51},
+	}
+	report := NewReport(Spec8Bits, 2, 11, results, 0)
+
+	assert.Equal(t, []int{11}, report.Summary.Working)
+	assert.Equal(t, 2, report.Results[0].Interval, "results are sorted")
+	assert.True(t, report.Summary.Matches, "11 is the only expected interval in [2, 11]")
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, report.Write(buf, FormatJSON))
+
+	decoded := Report{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, report, decoded)
+
+	buf.Reset()
+	require.NoError(t, report.Write(buf, FormatCSV))
+
+	rows, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, csvHeader, rows[0])
+	assert.Equal(t, []string{"8", "29", "3", "11", "true", "255", "3060", "1", "255", "0"}, rows[2])
+
+	buf.Reset()
+	require.NoError(t, report.Write(buf, FormatText))
+	assert.True(t, strings.HasPrefix(buf.String(), "tested intervals: [2, 11]\n[11]\n"))
+
+	assert.Error(t, report.Write(buf, "xml"))
+}
+
+func TestResultsFile(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "results8.txt", Spec8Bits.ResultsFile(FormatText))
+	assert.Equal(t, "results8.json", Spec8Bits.ResultsFile(FormatJSON))
+	assert.Equal(t, "results8.csv", Spec8Bits.ResultsFile(FormatCSV))
+}
@@ -0,0 +1,260 @@
+package solver
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ScheduleResult records a schedule of intervals that gives a maximal period, see sslfsr.ScheduledSSLFSR8
+type ScheduleResult struct {
+	Schedule []int `json:"schedule"`
+	Period   int   `json:"period"` // Next() calls until the register, counter and schedule all start over
+}
+
+// Schedules calls yield with every schedule of length intervals in [1, 2^width-2] that's worth testing,
+// until yield returns false. The schedule passed to yield is reused.
+// Rotating a schedule only changes where it starts, and a schedule repeating a shorter one repeats its
+// period, so only schedules that come before each of their rotations are kept. first limits them to
+// schedules starting with that interval, 0 for every schedule.
+func (spec Spec) Schedules(length int, first int, yield func(schedule []int) bool) {
+	last := spec.MaxRegister() - 1
+	schedule := make([]int, length)
+
+	for schedule[0] = max(first, 1); schedule[0] <= last; schedule[0]++ {
+		// the first interval of such a schedule is its smallest
+		for i := 1; i < length; i++ {
+			schedule[i] = schedule[0]
+		}
+
+		for {
+			if isLyndon(schedule) && !yield(schedule) {
+				return
+			}
+
+			// next schedule with the same first interval
+			i := length - 1
+			for i > 0 && schedule[i] == last {
+				schedule[i] = schedule[0]
+				i--
+			}
+			if i == 0 {
+				break
+			}
+			schedule[i]++
+		}
+
+		if first > 0 {
+			return
+		}
+	}
+}
+
+// isLyndon reports whether schedule comes strictly before every one of its rotations
+func isLyndon(schedule []int) bool {
+	n := len(schedule)
+
+	for rotation := 1; rotation < n; rotation++ {
+		for i := range n {
+			a, b := schedule[i], schedule[(i+rotation)%n]
+			if a < b {
+				break
+			}
+			if a > b || i == n-1 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// scheduleLUTs holds every interval's LUT, so schedules can be tested with lookups alone
+type scheduleLUTs [][]uint32
+
+func newScheduleLUTs(spec Spec) (luts scheduleLUTs) {
+	luts = make(scheduleLUTs, spec.MaxRegister())
+	for interval := 1; interval < len(luts); interval++ {
+		luts[interval] = make([]uint32, spec.States())
+		spec.FillLUT(interval, luts[interval])
+	}
+
+	return luts
+}
+
+// maximal reports whether passes through schedule take register 1 through every non-zero register
+func (luts scheduleLUTs) maximal(schedule []int) bool {
+	register := uint32(1)
+
+	// every interval's map is a permutation, so register 1 always comes back around
+	for pass := 1; ; pass++ {
+		for _, interval := range schedule {
+			register = luts[interval][register]
+		}
+
+		if register == 1 {
+			return pass == len(luts)
+		}
+	}
+}
+
+// SchedulePeriod is the period of a maximal schedule, every non-zero register once per pass
+func (spec Spec) SchedulePeriod(schedule []int) (period int) {
+	for _, interval := range schedule {
+		period += interval + 1
+	}
+
+	return period * spec.MaxRegister()
+}
+
+// SearchSchedules finds every schedule of length intervals giving spec's registers a maximal period.
+// Every interval's LUT is held at once, so this is only practical up to 10 bits or so. Workers take
+// the schedules starting with each interval in turn, tested is called on the calling goroutine with the
+// running total as each first interval finishes. Cancelling ctx stops handing out first intervals, the
+// schedules found so far are still returned along with ctx's error.
+func SearchSchedules(ctx context.Context, spec Spec, length int, workers int, tested func(int)) (results []ScheduleResult, err error) {
+	luts := newScheduleLUTs(spec)
+
+	todo := make(chan int)
+	done := make(chan scheduleBatch)
+
+	go func() {
+		defer close(todo)
+
+		Intervals(ctx, 1, spec.MaxRegister()-1, todo)
+	}()
+
+	wg := &sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for first := range todo {
+				batch := scheduleBatch{}
+				spec.Schedules(length, first, func(schedule []int) bool {
+					batch.tested++
+					if luts.maximal(schedule) {
+						batch.maximal = append(batch.maximal, ScheduleResult{
+							Schedule: slices.Clone(schedule),
+							Period:   spec.SchedulePeriod(schedule),
+						})
+					}
+
+					return true
+				})
+
+				done <- batch
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	results = []ScheduleResult{}
+	total := 0
+	for batch := range done {
+		results = append(results, batch.maximal...)
+		total += batch.tested
+		if tested != nil {
+			tested(total)
+		}
+	}
+
+	slices.SortFunc(results, func(a, b ScheduleResult) int {
+		return slices.Compare(a.Schedule, b.Schedule)
+	})
+
+	return results, ctx.Err()
+}
+
+type scheduleBatch struct {
+	tested  int
+	maximal []ScheduleResult
+}
+
+// ScheduleFormats lists every output format a ScheduleReport can be written in
+var ScheduleFormats = []string{FormatText, FormatJSON, FormatCSV}
+
+// ScheduleReport is everything a schedule search produces
+type ScheduleReport struct {
+	Width       int              `json:"width"`
+	Length      int              `json:"length"`    // intervals per schedule
+	Schedules   int              `json:"schedules"` // schedules worth testing, see Spec.Schedules
+	Tested      int              `json:"tested"`    // schedules tested before any interruption
+	Maximal     int              `json:"maximal"`
+	Results     []ScheduleResult `json:"results"` // every maximal schedule
+	Interrupted bool             `json:"interrupted"`
+}
+
+// NewScheduleReport summarizes the results of SearchSchedules
+func NewScheduleReport(spec Spec, length int, tested int, results []ScheduleResult) (report ScheduleReport) {
+	report = ScheduleReport{
+		Width:   spec.Width,
+		Length:  length,
+		Tested:  tested,
+		Maximal: len(results),
+		Results: results,
+	}
+
+	spec.Schedules(length, 0, func([]int) bool {
+		report.Schedules++
+		return true
+	})
+
+	return report
+}
+
+// Write encodes the report to out in the given format, text only lists the first top schedules
+func (report ScheduleReport) Write(out io.Writer, format string, top int) (err error) {
+	return writeFormat(out, format, top, report, report.writeText, report.writeCSV)
+}
+
+func (report ScheduleReport) writeText(out io.Writer, top int) (err error) {
+	bufout := bufio.NewWriter(out)
+
+	_, _ = bufout.WriteString(fmt.Sprintf("tested %d of %d schedules of %d intervals for %d bit registers\n", report.Tested, report.Schedules, report.Length, report.Width))
+	_, _ = bufout.WriteString(fmt.Sprintf("maximal schedules: %d\n", report.Maximal))
+	if report.Interrupted {
+		_, _ = bufout.WriteString("interrupted\n")
+	}
+
+	for _, r := range report.Results[:min(top, len(report.Results))] {
+		_, _ = bufout.WriteString(fmt.Sprintf("%v  period %d\n", r.Schedule, r.Period))
+	}
+
+	return bufout.Flush()
+}
+
+var scheduleCSVHeader = []string{"width", "schedule", "period"}
+
+func (report ScheduleReport) writeCSV(out io.Writer) (err error) {
+	w := csv.NewWriter(out)
+
+	_ = w.Write(scheduleCSVHeader)
+	for _, r := range report.Results {
+		intervals := make([]string, len(r.Schedule))
+		for i, interval := range r.Schedule {
+			intervals[i] = strconv.Itoa(interval)
+		}
+
+		_ = w.Write([]string{
+			strconv.Itoa(report.Width),
+			strings.Join(intervals, " "),
+			strconv.Itoa(r.Period),
+		})
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
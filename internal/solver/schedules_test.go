@@ -0,0 +1,96 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedules(t *testing.T) {
+	t.Parallel()
+
+	schedules := [][]int{}
+	Spec4Bits.Schedules(3, 0, func(schedule []int) bool {
+		schedules = append(schedules, append([]int{}, schedule...))
+		return true
+	})
+
+	// (14^3 - 14) / 3 schedules of 3 intervals from [1, 14] are no rotation of another, nor repeats
+	assert.Len(t, schedules, 910)
+	assert.Equal(t, []int{1, 1, 2}, schedules[0])
+	assert.NotContains(t, schedules, []int{1, 2, 1}, "a rotation of [1 1 2]")
+	assert.NotContains(t, schedules, []int{3, 3, 3}, "[3] repeated")
+
+	first := 0
+	Spec4Bits.Schedules(2, 5, func(schedule []int) bool {
+		assert.Equal(t, 5, schedule[0])
+		first++
+		return true
+	})
+	assert.Equal(t, 14-5, first)
+}
+
+func TestSearchSchedulesOfOne(t *testing.T) {
+	t.Parallel()
+
+	results, err := SearchSchedules(context.Background(), Spec8Bits, 1, 2, nil)
+	require.NoError(t, err)
+
+	intervals := []int{}
+	for _, r := range results {
+		intervals = append(intervals, r.Schedule[0])
+		assert.Equal(t, sslfsr.CalculateExpectedMaximalLength8Bits(uint8(r.Schedule[0])), r.Period)
+	}
+	assert.Equal(t, sslfsr.Intervals8Bits(), intervals, "a schedule of one interval is an SSLFSR8")
+}
+
+func TestSearchSchedules8Bits(t *testing.T) {
+	t.Parallel()
+
+	tested := 0
+	results, err := SearchSchedules(context.Background(), Spec8Bits, 2, 3, func(total int) { tested = total })
+	require.NoError(t, err)
+	assert.Equal(t, (254*254-254)/2, tested)
+	require.NotEmpty(t, results)
+
+	for _, r := range results {
+		schedule := []uint8{uint8(r.Schedule[0]), uint8(r.Schedule[1])}
+		assert.True(t, sslfsr.IsOptimalSchedule8Bits(schedule), "schedule %v", r.Schedule)
+		assert.Equal(t, sslfsr.CalculateSchedulePeriod8Bits(schedule), r.Period)
+	}
+
+	// a few that weren't found
+	for _, schedule := range [][]uint8{{1, 2}, {3, 200}, {11, 12}} {
+		found := false
+		for _, r := range results {
+			found = found || (r.Schedule[0] == int(schedule[0]) && r.Schedule[1] == int(schedule[1]))
+		}
+		assert.Equal(t, sslfsr.IsOptimalSchedule8Bits(schedule), found, "schedule %v", schedule)
+	}
+
+	report := NewScheduleReport(Spec8Bits, 2, tested, results)
+	assert.Equal(t, tested, report.Schedules)
+	assert.Equal(t, len(results), report.Maximal)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, report.Write(buf, FormatCSV, 0))
+	rows, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows, len(results)+1)
+	assert.Equal(t, scheduleCSVHeader, rows[0])
+}
+
+func TestSearchSchedulesInterrupted(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := SearchSchedules(ctx, Spec8Bits, 2, 2, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
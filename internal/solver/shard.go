@@ -0,0 +1,54 @@
+package solver
+
+import (
+	"fmt"
+)
+
+// Shard selects the i-th of n contiguous slices of an interval range, it satisfies flag.Value as "i/n"
+type Shard struct {
+	Index int // 1 based
+	Count int
+}
+
+// String formats the shard as i/n
+func (shard *Shard) String() string {
+	if shard.Count == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d/%d", shard.Index, shard.Count)
+}
+
+// Set parses i/n with 1 <= i <= n
+func (shard *Shard) Set(value string) (err error) {
+	var index, count int
+
+	_, err = fmt.Sscanf(value, "%d/%d", &index, &count)
+	if err != nil || fmt.Sprintf("%d/%d", index, count) != value {
+		return fmt.Errorf("shard must look like i/n, got %q", value)
+	}
+
+	if count < 1 || index < 1 || index > count {
+		return fmt.Errorf("shard %q must have 1 <= i <= n", value)
+	}
+
+	shard.Index = index
+	shard.Count = count
+
+	return nil
+}
+
+// Range returns this shard's slice of [start, end]. Shards differ in size by at most one interval
+// and together cover [start, end] exactly once.
+func (shard Shard) Range(start int, end int) (rng Range) {
+	if shard.Count == 0 {
+		return Range{Start: start, End: end}
+	}
+
+	total := end - start + 1
+
+	return Range{
+		Start: start + total*(shard.Index-1)/shard.Count,
+		End:   start + total*shard.Index/shard.Count - 1,
+	}
+}
@@ -0,0 +1,38 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardSet(t *testing.T) {
+	t.Parallel()
+
+	shard := Shard{}
+	require.NoError(t, shard.Set("2/5"))
+	assert.Equal(t, Shard{Index: 2, Count: 5}, shard)
+	assert.Equal(t, "2/5", shard.String())
+
+	for _, bad := range []string{"", "2", "0/3", "4/3", "1/0", "1/3x", "a/b", "-1/3"} {
+		assert.Error(t, (&Shard{}).Set(bad), bad)
+	}
+}
+
+func TestShardsCoverRangeOnce(t *testing.T) {
+	t.Parallel()
+
+	for _, count := range []int{1, 2, 3, 7, 254, 300} {
+		next := 1
+		for i := 1; i <= count; i++ {
+			rng := Shard{Index: i, Count: count}.Range(1, 254)
+			assert.Equal(t, next, rng.Start, "shard %d/%d", i, count)
+			next = rng.End + 1
+		}
+
+		assert.Equal(t, 255, next, "%d shards", count)
+	}
+
+	assert.Equal(t, Range{1, 254}, Shard{}.Range(1, 254), "no shard is everything")
+}
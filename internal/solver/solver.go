@@ -0,0 +1,147 @@
+package solver
+
+import (
+	"context"
+	"io"
+	"math/bits"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// WorkItem pairs an interval with a LUT mapping every register to the register one interval later
+type WorkItem struct {
+	LUT      []uint32
+	Interval int
+}
+
+// FillLUT fills lut, which must have an entry for every register, with the register one interval
+// later. The interval map is linear so each entry is an earlier entry XOR one column of its matrix,
+// which costs one lookup per register however long the interval is.
+func (spec Spec) FillLUT(interval int, lut []uint32) {
+	step := spec.IntervalMap(interval)
+
+	columns := make([]uint32, spec.Width)
+	for i := range columns {
+		columns[i] = uint32(step.Apply(1 << i))
+	}
+
+	lut[0] = 0
+	for register := 1; register < len(lut); register++ {
+		lut[register] = lut[register&(register-1)] ^ columns[bits.TrailingZeros(uint(register))]
+	}
+}
+
+// Solve tests every interval in [start, end] with one worker per progress line. Results are gathered on
+// the calling goroutine and returned once every worker has finished. When ctx is cancelled no further
+// intervals are handed out, the workers drain the few already queued, and the results gathered so far
+// are returned with ctx's error, unless they already cover every interval. Intervals are queued in
+// order so those results always cover [start, n] for some n.
+func Solve(ctx context.Context, spec Spec, start int, end int, progress Progress) (results []Result, err error) {
+	lines := progress.Lines()
+
+	// setup plumbing
+	todo := make(chan int, len(lines)*2)
+	working := make(chan Result, len(lines))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(lines))
+
+	// start workers
+	for _, line := range lines {
+		go Worker(spec, line, todo, working, wg)
+	}
+
+	go func() {
+		Intervals(ctx, start, end, todo)
+
+		// indicate to workers that no more input is coming, they will close
+		close(todo)
+	}()
+
+	go func() {
+		wg.Wait() // wait for workers to finish their final tasks
+		close(working)
+	}()
+
+	// gather results
+	results = []Result{}
+	for w := range working {
+		results = append(results, w)
+		progress.Tested(w)
+	}
+
+	// a cancellation after the last interval was gathered didn't interrupt anything
+	if len(results) == end-start+1 {
+		return results, nil
+	}
+
+	return results, ctx.Err()
+}
+
+// Intervals sends every interval in [start, end] to c in order, stopping early if ctx is cancelled
+func Intervals(ctx context.Context, start int, end int, c chan<- int) {
+	for interval := start; interval <= end; interval++ {
+		select {
+		case c <- interval:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Worker tests every interval from todo and sends its Result to results. Each worker builds the LUT
+// for its own intervals, registers too wide for LUTs are tested with TestLinear instead.
+func Worker(spec Spec, logger io.StringWriter, todo <-chan int, results chan<- Result, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	visited := NewBitset(spec.States())
+
+	work := &WorkItem{}
+	if spec.usesLUTs() {
+		work.LUT = make([]uint32, spec.States())
+	}
+
+	for interval := range todo {
+		_, _ = logger.WriteString(strconv.Itoa(interval))
+
+		if work.LUT == nil {
+			results <- TestLinear(spec, interval, visited)
+			continue
+		}
+
+		work.Interval = interval
+		spec.FillLUT(interval, work.LUT)
+
+		results <- Test(spec, work, visited)
+	}
+
+	_, _ = logger.WriteString("DONE")
+}
+
+// Test walks every cycle of the WorkItem's LUT. Shift and SubShift are both permutations so register 1
+// always comes back around, the interval is optimal if it took every non-zero register to get there.
+// visited must hold a bit for every register.
+func Test(spec Spec, work *WorkItem, visited Bitset) (result Result) {
+	start := time.Now()
+
+	cycles := FindCycles(spec.States(), func(register uint64) uint64 {
+		return uint64(work.LUT[register]) // shift, shift, ..., subshift = 1 interval
+	}, visited)
+
+	return newResult(spec, work.Interval, cycles, time.Since(start))
+}
+
+func newResult(spec Spec, interval int, cycles Cycles, elapsed time.Duration) (result Result) {
+	return Result{
+		Width:        spec.Width,
+		Taps:         spec.Taps,
+		SubTaps:      spec.SubTaps,
+		Interval:     interval,
+		Optimal:      cycles.FromOne == spec.MaxRegister(),
+		CycleLength:  cycles.FromOne,
+		Period:       cycles.FromOne * (interval + 1),
+		Cycles:       cycles.Count,
+		LargestCycle: cycles.Largest,
+		Elapsed:      elapsed,
+	}
+}
@@ -0,0 +1,206 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testProgress hands out silent lines and reports every gathered Result to tested
+type testProgress struct {
+	workers int
+	tested  func(Result)
+}
+
+func (p testProgress) Lines() []io.StringWriter {
+	lines := make([]io.StringWriter, p.workers)
+	for i := range lines {
+		lines[i] = discard{}
+	}
+
+	return lines
+}
+
+func (p testProgress) Tested(result Result) {
+	if p.tested != nil {
+		p.tested(result)
+	}
+}
+
+func (p testProgress) Finish() {}
+
+type discard struct{}
+
+func (discard) WriteString(s string) (int, error) {
+	return len(s), nil
+}
+
+// solve runs the whole pipeline for spec over [start, end]
+func solve(spec Spec, start int, end int) (results []Result) {
+	results, _ = Solve(context.Background(), spec, start, end, testProgress{workers: 3})
+
+	return results
+}
+
+func TestSolve8Bits(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport(Spec8Bits, 1, 254, solve(Spec8Bits, 1, 254), 0)
+
+	assert.True(t, report.Summary.Matches)
+	assert.Equal(t, 254, report.Summary.Tested)
+
+	for _, r := range report.Results {
+		reg := sslfsr.NewSSLFSR8(uint8(r.Interval))
+		if r.Optimal {
+			assert.Equal(t, reg.CalculateExpectedMaximalLength(), r.Period, "interval %d", r.Interval)
+			assert.Equal(t, 255, r.CycleLength)
+		} else {
+			assert.NotEqual(t, reg.CalculateExpectedMaximalLength(), r.Period, "interval %d", r.Interval)
+		}
+	}
+}
+
+func TestSolveFromStart(t *testing.T) {
+	t.Parallel()
+
+	all := NewReport(Spec8Bits, 1, 254, solve(Spec8Bits, 1, 254), 0)
+	tail := NewReport(Spec8Bits, 100, 254, solve(Spec8Bits, 100, 254), 0)
+
+	assert.Equal(t, 155, tail.Summary.Tested)
+	for i, r := range tail.Results {
+		r.Elapsed = 0
+		expected := all.Results[99+i]
+		expected.Elapsed = 0
+
+		assert.Equal(t, expected, r)
+	}
+}
+
+func TestFillLUT(t *testing.T) {
+	t.Parallel()
+
+	lut := make([]uint32, Spec16Bits.States())
+
+	for _, interval := range []int{1, 2, 255, 4000, 65534} {
+		Spec16Bits.FillLUT(interval, lut)
+
+		for _, register := range []uint32{0, 1, 2, 3, 0x00FF, 0x8001, 0xBEEF, 0xFFFF} {
+			expected := register
+			for range interval {
+				expected = Spec16Bits.Shift(expected)
+			}
+			expected = Spec16Bits.SubShift(expected)
+
+			assert.Equal(t, expected, lut[register], "interval %d register %d", interval, register)
+		}
+	}
+}
+
+func BenchmarkFillLUT16Bits(b *testing.B) {
+	lut := make([]uint32, Spec16Bits.States())
+
+	for i := range b.N {
+		Spec16Bits.FillLUT(i%Spec16Bits.MaxRegister()+1, lut)
+	}
+}
+
+// BenchmarkSolve16Bits measures how the solver scales with workers, which each build their own LUTs
+func BenchmarkSolve16Bits(b *testing.B) {
+	for _, workers := range []int{1, 2, 4, 8, 16} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for range b.N {
+				_, _ = Solve(context.Background(), Spec16Bits, 1, 256, testProgress{workers: workers})
+			}
+		})
+	}
+}
+
+func TestSolveInterrupted(t *testing.T) {
+	t.Parallel()
+
+	expected := NewReport(Spec8Bits, 1, 254, solve(Spec8Bits, 1, 254), 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gathered := 0
+	progress := testProgress{workers: 4, tested: func(Result) {
+		gathered++
+		if gathered == 20 {
+			cancel()
+		}
+	}}
+
+	results, err := Solve(ctx, Spec8Bits, 1, 254, progress)
+	require.ErrorIs(t, err, context.Canceled)
+
+	report := NewReport(Spec8Bits, 1, 254, results, 0)
+	report.Summary.Interrupted = true
+
+	assert.Equal(t, gathered, len(results), "every gathered result is returned")
+	assert.GreaterOrEqual(t, len(results), 20)
+	assert.Less(t, len(results), 254, "interruption stops the search early")
+	assert.Equal(t, ExitInterrupted, report.Summary.ExitCode())
+
+	// results cover a prefix of the intervals and agree with an uninterrupted run
+	for i, r := range report.Results {
+		assert.Equal(t, i+1, r.Interval)
+		assert.Equal(t, expected.Results[i].CycleLength, r.CycleLength)
+	}
+}
+
+func TestSolveCancelledAfterLastResult(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gathered := 0
+	progress := testProgress{workers: 2, tested: func(Result) {
+		gathered++
+		if gathered == 30 {
+			cancel()
+		}
+	}}
+
+	results, err := Solve(ctx, Spec8Bits, 1, 30, progress)
+	assert.NoError(t, err, "every interval was gathered")
+	assert.Len(t, results, 30)
+}
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ExitOK, Summary{Matches: true}.ExitCode())
+	assert.Equal(t, ExitMismatch, Summary{}.ExitCode())
+	assert.Equal(t, ExitInterrupted, Summary{Interrupted: true}.ExitCode())
+	assert.Equal(t, ExitInterrupted, Summary{Matches: true, Interrupted: true}.ExitCode())
+}
+
+func TestEmitGo(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "intervals8.go")
+
+	report := NewReport(Spec8Bits, 1, 254, solve(Spec8Bits, 1, 254), 0)
+	require.NoError(t, emitGo(Spec8Bits, report, name))
+
+	src, err := os.ReadFile(name)
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func Intervals8Bits() (working []int) {\n\treturn []int{\n\t\t1,\n\t\t11,\n")
+
+	partial := NewReport(Spec8Bits, 1, 100, solve(Spec8Bits, 1, 100), 0)
+	assert.Error(t, emitGo(Spec8Bits, partial, name), "a partial search can't be emitted")
+
+	wrong := Spec8Bits
+	wrong.Verify = func(interval int) bool { return interval != 11 }
+	assert.ErrorContains(t, emitGo(wrong, report, name), "interval 11")
+}
@@ -0,0 +1,66 @@
+package solver
+
+import "fmt"
+
+// Spec describes a register width the solver can search intervals for
+type Spec struct {
+	Width    int                 // register width in bits
+	Taps     uint64              // feedback taps applied by Shift
+	SubTaps  uint64              // feedback taps applied by SubShift
+	Shift    func(uint32) uint32 // standard LFSR shift of the whole register
+	SubShift func(uint32) uint32 // LFSR shift of just the lower bits
+	Expected func() []int        // known optimum intervals, e.g. sslfsr.Intervals16Bits, nil if none are known
+	Verify   func(int) bool      // proves an interval's full Next() period, e.g. sslfsr.VerifyInterval16Bits
+	End      int                 // last interval searched by default, 0 for every interval below 2^Width-1
+}
+
+// States returns the number of register values, including the zero lockup state
+func (spec Spec) States() int {
+	return 1 << spec.Width
+}
+
+// MaxRegister returns the largest register value, which is also the length of a maximal cycle
+func (spec Spec) MaxRegister() int {
+	return spec.States() - 1
+}
+
+// DefaultRange returns the intervals searched when no range is given
+func (spec Spec) DefaultRange() Range {
+	if spec.End > 0 {
+		return Range{Start: 1, End: spec.End}
+	}
+
+	return Range{Start: 1, End: spec.MaxRegister() - 1}
+}
+
+// ExpectedWithin returns the known optimum intervals within [start, end], repeated every 2^Width-1
+// intervals beyond the register width
+func (spec Spec) ExpectedWithin(start int, end int) (expected []int) {
+	expected = []int{}
+	if spec.Expected == nil {
+		return expected
+	}
+
+	known := spec.Expected()
+	cycle := spec.MaxRegister()
+
+	for base := start / cycle * cycle; base <= end; base += cycle {
+		for _, interval := range known {
+			if base+interval >= start && base+interval <= end {
+				expected = append(expected, base+interval)
+			}
+		}
+	}
+
+	return expected
+}
+
+// ResultsFile returns the default results file name for the given output format
+func (spec Spec) ResultsFile(format string) string {
+	ext := format
+	if format == FormatText {
+		ext = "txt"
+	}
+
+	return fmt.Sprintf("results%d.%s", spec.Width, ext)
+}
@@ -0,0 +1,107 @@
+package solver
+
+import "github.com/coreyog/sslfsr"
+
+// Spec4Bits searches intervals for SSLFSR4
+var Spec4Bits = Spec{
+	Width:   4,
+	Taps:    sslfsr.Taps4Bits,
+	SubTaps: sslfsr.SubTaps4Bits,
+	Shift: func(register uint32) uint32 {
+		return uint32(sslfsr.Shift4Bits(uint8(register)))
+	},
+	SubShift: func(register uint32) uint32 {
+		return uint32(sslfsr.SubShift4Bits(uint8(register)))
+	},
+	Verify: func(interval int) bool {
+		return sslfsr.VerifyWideInterval4Bits(uint32(interval))
+	},
+	Expected: sslfsr.Intervals4Bits,
+}
+
+// Spec8Bits searches intervals for SSLFSR8
+var Spec8Bits = Spec{
+	Width:   8,
+	Taps:    sslfsr.Taps8Bits,
+	SubTaps: sslfsr.SubTaps8Bits,
+	Shift: func(register uint32) uint32 {
+		return uint32(sslfsr.Shift8Bits(uint8(register)))
+	},
+	SubShift: func(register uint32) uint32 {
+		return uint32(sslfsr.SubShift8Bits(uint8(register)))
+	},
+	Verify: func(interval int) bool {
+		return sslfsr.VerifyWideInterval8Bits(uint32(interval))
+	},
+	Expected: sslfsr.Intervals8Bits,
+}
+
+// Spec16Bits searches intervals for SSLFSR16
+var Spec16Bits = Spec{
+	Width:   16,
+	Taps:    sslfsr.Taps16Bits,
+	SubTaps: sslfsr.SubTaps16Bits,
+	Shift: func(register uint32) uint32 {
+		return uint32(sslfsr.Shift16Bits(uint16(register)))
+	},
+	SubShift: func(register uint32) uint32 {
+		return uint32(sslfsr.SubShift16Bits(uint16(register)))
+	},
+	Verify: func(interval int) bool {
+		return sslfsr.VerifyWideInterval16Bits(uint32(interval))
+	},
+	Expected: sslfsr.Intervals16Bits,
+}
+
+// Spec32Bits searches intervals for SSLFSR32. No intervals are known, and searching all 4 billion of
+// them isn't practical, so by default it only searches the first 64.
+var Spec32Bits = Spec{
+	Width:   32,
+	Taps:    sslfsr.Taps32Bits,
+	SubTaps: sslfsr.SubTaps32Bits,
+	Shift: func(register uint32) uint32 {
+		return sslfsr.Shift32Bits(register)
+	},
+	SubShift: func(register uint32) uint32 {
+		return sslfsr.SubShift32Bits(register)
+	},
+	Verify: func(interval int) bool {
+		return sslfsr.VerifyInterval32Bits(uint32(interval))
+	},
+	End: 64,
+}
+
+// SpecFor returns the Spec for a register width the library supports, the fixed width types where
+// there is one
+func SpecFor(width int) (spec Spec, ok bool) {
+	switch width {
+	case 4:
+		return Spec4Bits, true
+	case 8:
+		return Spec8Bits, true
+	case 16:
+		return Spec16Bits, true
+	case 32:
+		return Spec32Bits, true
+	default:
+		return WidthSpec(width)
+	}
+}
+
+// WidthSpec searches intervals for an SSLFSR of any width with sslfsr.DefaultTaps
+func WidthSpec(width int) (spec Spec, ok bool) {
+	taps, subTaps, ok := sslfsr.DefaultTaps(width)
+	if !ok {
+		return Spec{}, false
+	}
+
+	spec = TapSpec(width, taps, subTaps)
+	if _, known := sslfsr.KnownIntervals(width); known {
+		spec.Expected = func() []int {
+			intervals, _ := sslfsr.KnownIntervals(width)
+			return intervals
+		}
+	}
+
+	return spec, true
+}
@@ -0,0 +1,65 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWidthSpec(t *testing.T) {
+	t.Parallel()
+
+	spec, ok := WidthSpec(12)
+	require.True(t, ok)
+
+	report := NewReport(spec, 1, 4094, solve(spec, 1, 4094), 0)
+	assert.Equal(t, sslfsr.Intervals12Bits(), report.Summary.Working)
+	assert.True(t, report.Summary.Matches)
+
+	spec, ok = WidthSpec(20)
+	require.True(t, ok)
+	assert.Nil(t, spec.Expected, "no 20 bit intervals are known")
+	assert.Equal(t, Range{Start: 1, End: 1<<20 - 2}, spec.DefaultRange())
+
+	_, ok = WidthSpec(sslfsr.MaxWidth + 1)
+	assert.False(t, ok)
+}
+
+func TestSpecFor(t *testing.T) {
+	t.Parallel()
+
+	spec, ok := SpecFor(16)
+	require.True(t, ok)
+	assert.Equal(t, uint64(sslfsr.Taps16Bits), spec.Taps)
+
+	spec, ok = SpecFor(7)
+	require.True(t, ok)
+	assert.Equal(t, 7, spec.Width)
+
+	_, ok = SpecFor(64)
+	assert.False(t, ok)
+}
+
+func TestExpectedWithin(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{1, 7, 11, 13}, Spec4Bits.ExpectedWithin(1, 14))
+	assert.Equal(t, []int{11, 13, 16, 22, 26, 28}, Spec4Bits.ExpectedWithin(8, 29), "repeats every 15 intervals")
+	assert.Equal(t, []int{}, Spec4Bits.ExpectedWithin(14, 15))
+	assert.Equal(t, []int{}, Spec32Bits.ExpectedWithin(1, 64))
+}
+
+func TestSolveBeyondRegisterWidth(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport(Spec8Bits, 200, 800, solve(Spec8Bits, 200, 800), 0)
+	assert.True(t, report.Summary.Matches, "optimal intervals repeat every 255 intervals")
+	assert.Contains(t, report.Summary.Working, 11+255)
+	assert.Contains(t, report.Summary.Working, 11+2*255)
+
+	verification := Verify(Spec16Bits, 65530, 70000)
+	assert.NotEmpty(t, verification.Verified)
+	assert.Empty(t, verification.Failed)
+}
@@ -0,0 +1,130 @@
+package solver
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// syncEvery is the longest a non-optimal Result waits to be synced to disk
+const syncEvery = time.Second
+
+// Stream appends every Result to an NDJSON file the moment it is gathered, so a crash loses at most
+// the intervals still being tested and the file can be tailed while the search runs. Optimal results
+// are synced to disk straight away and echoed, the rest are synced at least every syncEvery.
+type Stream struct {
+	file     *os.File
+	echo     io.Writer // optimal results are copied here, nil for nowhere
+	mu       sync.Mutex
+	lastSync time.Time
+	err      error // the first write or sync error, returned by Close
+}
+
+// CreateStream truncates or creates the named file, - streams to stdout instead
+func CreateStream(name string, echo io.Writer) (stream *Stream, err error) {
+	stream = &Stream{echo: echo, lastSync: time.Now()}
+
+	if name == "-" {
+		stream.file = os.Stdout
+		stream.echo = nil // already on stdout
+
+		return stream, nil
+	}
+
+	stream.file, err = os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// Write appends a Result, it is safe to call from multiple goroutines
+func (stream *Stream) Write(result Result) {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	line, err := json.Marshal(result)
+	if err != nil {
+		stream.fail(err)
+		return
+	}
+	line = append(line, '\n')
+
+	if _, err := stream.file.Write(line); err != nil {
+		stream.fail(err)
+		return
+	}
+
+	if result.Optimal && stream.echo != nil {
+		_, _ = stream.echo.Write(line)
+	}
+
+	if result.Optimal || time.Since(stream.lastSync) >= syncEvery {
+		stream.sync()
+	}
+}
+
+// WriteAll appends every Result in order
+func (stream *Stream) WriteAll(results []Result) {
+	for _, result := range results {
+		stream.Write(result)
+	}
+}
+
+// Close syncs and closes the file, returning the first error the stream ran into
+func (stream *Stream) Close() (err error) {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if stream.file == os.Stdout {
+		return stream.err
+	}
+
+	stream.sync()
+	stream.fail(stream.file.Close())
+
+	return stream.err
+}
+
+// sync flushes the file to disk, mu must be held
+func (stream *Stream) sync() {
+	stream.lastSync = time.Now()
+
+	if stream.file == os.Stdout {
+		return // stdout may be a pipe or terminal, which can't be synced
+	}
+
+	stream.fail(stream.file.Sync())
+}
+
+// fail records err unless an earlier error already was, mu must be held
+func (stream *Stream) fail(err error) {
+	if stream.err == nil {
+		stream.err = err
+	}
+}
+
+// ReadReportFile reads the named results file, streamed or written whole, see ReadReport
+func ReadReportFile(name string) (report Report, err error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return report, err
+	}
+	defer f.Close()
+
+	return ReadReport(f)
+}
+
+// streamProgress writes every gathered Result to a Stream before passing it on
+type streamProgress struct {
+	Progress
+	stream *Stream
+}
+
+func (p streamProgress) Tested(result Result) {
+	p.stream.Write(result)
+	p.Progress.Tested(result)
+}
@@ -0,0 +1,76 @@
+package solver
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "results8.ndjson")
+	echo := &bytes.Buffer{}
+
+	stream, err := CreateStream(name, echo)
+	require.NoError(t, err)
+
+	results := solve(Spec8Bits, 1, 254)
+	stream.WriteAll(results[:100])
+
+	// the file is readable while the search is still running
+	partial, err := ReadReportFile(name)
+	require.NoError(t, err)
+	assert.Len(t, partial.Results, 100)
+
+	stream.WriteAll(results[100:])
+	require.NoError(t, stream.Close())
+
+	streamed, err := ReadReportFile(name)
+	require.NoError(t, err)
+	assert.Equal(t, results, streamed.Results)
+	assert.Equal(t, 8, streamed.Summary.Width)
+	assert.Equal(t, 1, streamed.Summary.Start)
+	assert.Equal(t, 254, streamed.Summary.End)
+
+	report := NewReport(Spec8Bits, 1, 254, streamed.Results, 0)
+	assert.True(t, report.Summary.Matches)
+
+	// only optimal results are echoed
+	lines := strings.Split(strings.TrimSpace(echo.String()), "\n")
+	assert.Len(t, lines, report.Summary.WorkingCount)
+	for _, line := range lines {
+		assert.Contains(t, line, `"optimal":true`)
+	}
+}
+
+func TestReadTruncatedStream(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	report := NewReport(Spec8Bits, 1, 20, solve(Spec8Bits, 1, 20), 0)
+	require.NoError(t, report.Write(buf, FormatNDJSON))
+
+	// a crash part way through writing the last line
+	truncated := buf.Bytes()[:buf.Len()-30]
+
+	read, err := ReadReport(bytes.NewReader(truncated))
+	require.NoError(t, err)
+	assert.Len(t, read.Results, 19)
+	assert.Equal(t, report.Results[:19], read.Results)
+}
+
+func TestStreamToStdout(t *testing.T) {
+	t.Parallel()
+
+	stream, err := CreateStream("-", &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.Equal(t, os.Stdout, stream.file)
+	assert.Nil(t, stream.echo, "results already go to stdout")
+	assert.NoError(t, stream.Close())
+}
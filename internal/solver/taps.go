@@ -0,0 +1,241 @@
+package solver
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/coreyog/sslfsr/internal/gf2"
+)
+
+// TapSpec returns a Spec for a width bit register with arbitrary taps, SubShift shifts the lower half
+// of the register as it does for every SSLFSR
+func TapSpec(width int, taps uint64, subTaps uint64) (spec Spec) {
+	spec = Spec{
+		Width:   width,
+		Taps:    taps,
+		SubTaps: subTaps,
+		Shift: func(register uint32) uint32 {
+			return uint32(sslfsr.ShiftWidth(uint64(register), width, taps))
+		},
+		SubShift: func(register uint32) uint32 {
+			return uint32(sslfsr.SubShiftWidth(uint64(register), width/2, subTaps))
+		},
+	}
+
+	spec.Verify = func(interval int) bool {
+		return spec.IntervalMap(interval).CycleIs(1, uint64(spec.MaxRegister()))
+	}
+
+	return spec
+}
+
+// PrimitiveTaps returns every set of taps that gives a width bit LFSR a maximal cycle, in ascending
+// order. Taps without bit 0 throw away the bit shifted out and can never be maximal.
+func PrimitiveTaps(width int) (taps []uint64) {
+	taps = []uint64{}
+	cycle := uint64(1)<<width - 1
+
+	for t := uint64(1); t <= cycle; t += 2 {
+		shift := gf2.FromFunc(width, func(x uint64) uint64 {
+			return sslfsr.ShiftWidth(x, width, t)
+		})
+
+		if shift.CycleIs(1, cycle) {
+			taps = append(taps, t)
+		}
+	}
+
+	return taps
+}
+
+// TapResult records every optimal interval for one choice of taps
+type TapResult struct {
+	Taps      uint64 `json:"taps"`
+	SubTaps   uint64 `json:"subtaps"`
+	Count     int    `json:"count"`
+	Intervals []int  `json:"intervals"`
+}
+
+// OptimalIntervals returns every optimal interval below 2^width-1 for a width bit register with the
+// given taps. Each interval's map is proven with matrix powers, so this is quick up to 12 bits or so.
+func OptimalIntervals(width int, taps uint64, subTaps uint64) (result TapResult) {
+	shift := gf2.FromFunc(width, func(x uint64) uint64 {
+		return sslfsr.ShiftWidth(x, width, taps)
+	})
+	subshift := gf2.FromFunc(width, func(x uint64) uint64 {
+		return sslfsr.SubShiftWidth(x, width/2, subTaps)
+	})
+
+	result = TapResult{Taps: taps, SubTaps: subTaps, Intervals: []int{}}
+	cycle := uint64(1)<<width - 1
+
+	shifts := gf2.Identity(width) // grows by one Shift per interval
+	for interval := 1; uint64(interval) < cycle; interval++ {
+		shifts = shift.Mul(shifts)
+
+		if subshift.Mul(shifts).CycleIs(1, cycle) {
+			result.Intervals = append(result.Intervals, interval)
+		}
+	}
+
+	result.Count = len(result.Intervals)
+
+	return result
+}
+
+// SearchTaps finds the optimal intervals of every pair of primitive full register and sub register
+// taps for a width bit register. found is called on the calling goroutine as each pair finishes.
+// Cancelling ctx stops handing out pairs, the pairs already searched are still returned along with
+// ctx's error.
+func SearchTaps(ctx context.Context, width int, workers int, found func(TapResult)) (results []TapResult, err error) {
+	todo := make(chan TapResult)
+	done := make(chan TapResult)
+
+	go func() {
+		defer close(todo)
+
+		for _, taps := range PrimitiveTaps(width) {
+			for _, subTaps := range PrimitiveTaps(width / 2) {
+				select {
+				case todo <- TapResult{Taps: taps, SubTaps: subTaps}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	wg := &sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for pair := range todo {
+				done <- OptimalIntervals(width, pair.Taps, pair.SubTaps)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	results = []TapResult{}
+	for result := range done {
+		results = append(results, result)
+		if found != nil {
+			found(result)
+		}
+	}
+
+	// most optimal intervals first
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Taps != b.Taps {
+			return a.Taps < b.Taps
+		}
+
+		return a.SubTaps < b.SubTaps
+	})
+
+	return results, ctx.Err()
+}
+
+// TapFormats lists every output format a TapReport can be written in
+var TapFormats = []string{FormatText, FormatJSON, FormatCSV}
+
+// TapReport is everything a tap search produces
+type TapReport struct {
+	Width       int         `json:"width"`
+	SubWidth    int         `json:"sub_width"`
+	Pairs       int         `json:"pairs"`  // primitive taps times primitive sub taps
+	Tested      int         `json:"tested"` // pairs searched before any interruption
+	Best        []TapResult `json:"best"`   // the pairs with the most optimal intervals
+	Results     []TapResult `json:"results"`
+	Interrupted bool        `json:"interrupted"`
+}
+
+// NewTapReport summarizes the results of SearchTaps, which must already be sorted
+func NewTapReport(width int, results []TapResult) (report TapReport) {
+	report = TapReport{
+		Width:    width,
+		SubWidth: width / 2,
+		Pairs:    len(PrimitiveTaps(width)) * len(PrimitiveTaps(width/2)),
+		Tested:   len(results),
+		Best:     []TapResult{},
+		Results:  results,
+	}
+
+	for _, r := range results {
+		if r.Count != results[0].Count {
+			break
+		}
+
+		report.Best = append(report.Best, r)
+	}
+
+	return report
+}
+
+// Write encodes the report to out in the given format, text only lists the top results
+func (report TapReport) Write(out io.Writer, format string, top int) (err error) {
+	return writeFormat(out, format, top, report, report.writeText, report.writeCSV)
+}
+
+func (report TapReport) writeText(out io.Writer, top int) (err error) {
+	bufout := bufio.NewWriter(out)
+
+	_, _ = bufout.WriteString(fmt.Sprintf("searched %d of %d tap pairs for %d bit registers\n", report.Tested, report.Pairs, report.Width))
+	if len(report.Best) > 0 {
+		_, _ = bufout.WriteString(fmt.Sprintf("most optimal intervals: %d, from %d tap pairs\n", report.Best[0].Count, len(report.Best)))
+	}
+	if report.Interrupted {
+		_, _ = bufout.WriteString("interrupted\n")
+	}
+
+	_, _ = bufout.WriteString(fmt.Sprintf("\n%-*s  %-*s  %5s  intervals\n", report.Width+2, "taps", report.SubWidth+2, "subtaps", "count"))
+	for _, r := range report.Results[:min(top, len(report.Results))] {
+		_, _ = bufout.WriteString(fmt.Sprintf("0b%0*b  0b%0*b  %5d  %v\n", report.Width, r.Taps, report.SubWidth, r.SubTaps, r.Count, r.Intervals))
+	}
+
+	return bufout.Flush()
+}
+
+var tapCSVHeader = []string{"width", "taps", "subtaps", "count", "intervals"}
+
+func (report TapReport) writeCSV(out io.Writer) (err error) {
+	w := csv.NewWriter(out)
+
+	_ = w.Write(tapCSVHeader)
+	for _, r := range report.Results {
+		intervals := make([]string, len(r.Intervals))
+		for i, interval := range r.Intervals {
+			intervals[i] = strconv.Itoa(interval)
+		}
+
+		_ = w.Write([]string{
+			strconv.Itoa(report.Width),
+			strconv.FormatUint(r.Taps, 10),
+			strconv.FormatUint(r.SubTaps, 10),
+			strconv.Itoa(r.Count),
+			strings.Join(intervals, " "),
+		})
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
@@ -0,0 +1,87 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+
+	"github.com/coreyog/sslfsr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrimitiveTaps(t *testing.T) {
+	t.Parallel()
+
+	// there are phi(2^n-1)/n primitive polynomials of degree n
+	assert.Len(t, PrimitiveTaps(4), 2)
+	assert.Len(t, PrimitiveTaps(6), 6)
+	assert.Len(t, PrimitiveTaps(8), 16)
+	assert.Len(t, PrimitiveTaps(12), 144)
+
+	assert.Contains(t, PrimitiveTaps(8), uint64(sslfsr.Taps8Bits))
+	assert.Contains(t, PrimitiveTaps(4), uint64(sslfsr.SubTaps8Bits))
+	assert.Contains(t, PrimitiveTaps(16), uint64(sslfsr.Taps16Bits))
+}
+
+func TestTapSpec(t *testing.T) {
+	t.Parallel()
+
+	spec := TapSpec(8, sslfsr.Taps8Bits, sslfsr.SubTaps8Bits)
+	for register := range uint32(256) {
+		assert.Equal(t, Spec8Bits.Shift(register), spec.Shift(register))
+		assert.Equal(t, Spec8Bits.SubShift(register), spec.SubShift(register))
+	}
+
+	for _, interval := range sslfsr.Intervals8Bits() {
+		assert.True(t, spec.Verify(interval), "interval %d", interval)
+	}
+}
+
+func TestOptimalIntervals(t *testing.T) {
+	t.Parallel()
+
+	result := OptimalIntervals(8, sslfsr.Taps8Bits, sslfsr.SubTaps8Bits)
+	assert.Equal(t, sslfsr.Intervals8Bits(), result.Intervals)
+	assert.Equal(t, len(result.Intervals), result.Count)
+}
+
+func TestSearchTaps(t *testing.T) {
+	t.Parallel()
+
+	found := 0
+	results, err := SearchTaps(context.Background(), 8, 4, func(TapResult) { found++ })
+	require.NoError(t, err)
+	assert.Len(t, results, 16*2)
+	assert.Equal(t, len(results), found)
+
+	for i := 1; i < len(results); i++ {
+		assert.GreaterOrEqual(t, results[i-1].Count, results[i].Count, "most optimal intervals first")
+	}
+
+	report := NewTapReport(8, results)
+	assert.Equal(t, 32, report.Pairs)
+	assert.NotEmpty(t, report.Best)
+	for _, best := range report.Best {
+		assert.Equal(t, results[0].Count, best.Count)
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, report.Write(buf, FormatCSV, 0))
+	rows, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows, 33)
+	assert.Equal(t, tapCSVHeader, rows[0])
+}
+
+func TestSearchTapsInterrupted(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := SearchTaps(ctx, 8, 2, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, len(results), 32)
+}
@@ -0,0 +1,61 @@
+package solver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// Verification is the outcome of proving the full Next() period of every known optimum interval in
+// a range, rather than searching it
+type Verification struct {
+	Width    int   `json:"width"`
+	Start    int   `json:"start"`
+	End      int   `json:"end"`
+	Verified []int `json:"verified"`
+	Failed   []int `json:"failed"`
+}
+
+// Verify proves the full Next() period of every known optimum interval in [start, end] with
+// spec.Verify, which covers the counter as well as the register
+func Verify(spec Spec, start int, end int) (verification Verification) {
+	verification = Verification{
+		Width:    spec.Width,
+		Start:    start,
+		End:      end,
+		Verified: []int{},
+		Failed:   []int{},
+	}
+
+	for _, interval := range spec.ExpectedWithin(start, end) {
+		if spec.Verify(interval) {
+			verification.Verified = append(verification.Verified, interval)
+		} else {
+			verification.Failed = append(verification.Failed, interval)
+		}
+	}
+
+	return verification
+}
+
+// WriteText writes a human readable summary of the verification
+func (verification Verification) WriteText(out io.Writer) (err error) {
+	bufout := bufio.NewWriter(out)
+
+	_, _ = bufout.WriteString(fmt.Sprintf("verified intervals: [%d, %d]\n", verification.Start, verification.End))
+	_, _ = bufout.WriteString(fmt.Sprintf("full period proven: %d of %d\n", len(verification.Verified), len(verification.Verified)+len(verification.Failed)))
+	if len(verification.Failed) > 0 {
+		_, _ = bufout.WriteString(fmt.Sprintf("failed: %v\n", verification.Failed))
+	}
+
+	return bufout.Flush()
+}
+
+// ExitCode is the solver's exit code for this verification
+func (verification Verification) ExitCode() (code int) {
+	if len(verification.Failed) > 0 {
+		return ExitMismatch
+	}
+
+	return ExitOK
+}
@@ -0,0 +1,30 @@
+package solver
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	verification := Verify(Spec16Bits, 1, 1000)
+	assert.Equal(t, Spec16Bits.ExpectedWithin(1, 1000), verification.Verified)
+	assert.Empty(t, verification.Failed)
+	assert.Equal(t, ExitOK, verification.ExitCode())
+
+	wrong := Spec8Bits
+	wrong.Expected = func() []int { return []int{2, 11} }
+
+	verification = Verify(wrong, 1, 254)
+	assert.Equal(t, []int{11}, verification.Verified)
+	assert.Equal(t, []int{2}, verification.Failed)
+	assert.Equal(t, ExitMismatch, verification.ExitCode())
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, verification.WriteText(buf))
+	assert.Equal(t, "verified intervals: [1, 254]\nfull period proven: 1 of 2\nfailed: [2]\n", buf.String())
+}
@@ -0,0 +1,78 @@
+// Code generated by "genintervals --width 10 testdata/results10.csv"; DO NOT EDIT.
+
+package sslfsr
+
+// Intervals10Bits returns a list of known optimum intervals
+func Intervals10Bits() (working []int) {
+	return []int{
+		6,
+		28,
+		36,
+		72,
+		78,
+		81,
+		87,
+		91,
+		107,
+		116,
+		152,
+		154,
+		165,
+		177,
+		178,
+		215,
+		250,
+		266,
+		285,
+		288,
+		289,
+		299,
+		325,
+		334,
+		367,
+		381,
+		382,
+		395,
+		401,
+		433,
+		460,
+		461,
+		464,
+		467,
+		471,
+		486,
+		487,
+		497,
+		543,
+		582,
+		594,
+		601,
+		649,
+		654,
+		656,
+		659,
+		671,
+		686,
+		687,
+		701,
+		715,
+		720,
+		727,
+		735,
+		751,
+		768,
+		785,
+		822,
+		856,
+		858,
+		895,
+		901,
+		904,
+		918,
+		939,
+		947,
+		1003,
+		1013,
+		1014,
+	}
+}
@@ -0,0 +1,196 @@
+// Code generated by "genintervals --width 12 testdata/results12.csv"; DO NOT EDIT.
+
+package sslfsr
+
+// Intervals12Bits returns a list of known optimum intervals
+func Intervals12Bits() (working []int) {
+	return []int{
+		1,
+		13,
+		38,
+		57,
+		83,
+		93,
+		107,
+		144,
+		163,
+		179,
+		180,
+		185,
+		186,
+		189,
+		190,
+		192,
+		209,
+		218,
+		224,
+		226,
+		231,
+		236,
+		246,
+		262,
+		275,
+		283,
+		286,
+		300,
+		305,
+		314,
+		353,
+		364,
+		374,
+		437,
+		442,
+		456,
+		478,
+		501,
+		537,
+		604,
+		605,
+		617,
+		625,
+		645,
+		651,
+		674,
+		720,
+		749,
+		766,
+		773,
+		791,
+		805,
+		808,
+		935,
+		939,
+		967,
+		970,
+		990,
+		1021,
+		1041,
+		1114,
+		1185,
+		1201,
+		1211,
+		1212,
+		1234,
+		1237,
+		1297,
+		1316,
+		1327,
+		1336,
+		1425,
+		1542,
+		1573,
+		1587,
+		1609,
+		1613,
+		1635,
+		1644,
+		1724,
+		1737,
+		1746,
+		1772,
+		1851,
+		1857,
+		1898,
+		1979,
+		1987,
+		1995,
+		1996,
+		2024,
+		2050,
+		2058,
+		2075,
+		2119,
+		2121,
+		2164,
+		2188,
+		2198,
+		2199,
+		2219,
+		2246,
+		2277,
+		2310,
+		2321,
+		2351,
+		2384,
+		2385,
+		2394,
+		2462,
+		2467,
+		2472,
+		2516,
+		2554,
+		2592,
+		2598,
+		2608,
+		2667,
+		2714,
+		2746,
+		2760,
+		2764,
+		2777,
+		2790,
+		2834,
+		2892,
+		2907,
+		2931,
+		2974,
+		3000,
+		3003,
+		3025,
+		3037,
+		3051,
+		3054,
+		3086,
+		3091,
+		3095,
+		3097,
+		3124,
+		3149,
+		3206,
+		3240,
+		3253,
+		3257,
+		3262,
+		3265,
+		3271,
+		3281,
+		3299,
+		3310,
+		3319,
+		3330,
+		3338,
+		3352,
+		3374,
+		3376,
+		3389,
+		3390,
+		3433,
+		3467,
+		3476,
+		3479,
+		3525,
+		3534,
+		3563,
+		3579,
+		3658,
+		3712,
+		3723,
+		3736,
+		3747,
+		3750,
+		3774,
+		3818,
+		3857,
+		3859,
+		3876,
+		3878,
+		3908,
+		3928,
+		3933,
+		3971,
+		3977,
+		4015,
+		4068,
+		4076,
+	}
+}
@@ -0,0 +1,1032 @@
+// Code generated by "genintervals --width 14 testdata/results14.csv"; DO NOT EDIT.
+
+package sslfsr
+
+// Intervals14Bits returns a list of known optimum intervals
+func Intervals14Bits() (working []int) {
+	return []int{
+		1,
+		7,
+		21,
+		43,
+		45,
+		57,
+		107,
+		113,
+		130,
+		142,
+		147,
+		151,
+		152,
+		163,
+		171,
+		190,
+		196,
+		197,
+		203,
+		212,
+		227,
+		244,
+		253,
+		277,
+		282,
+		292,
+		314,
+		330,
+		338,
+		344,
+		345,
+		356,
+		367,
+		380,
+		388,
+		417,
+		426,
+		434,
+		437,
+		464,
+		467,
+		502,
+		518,
+		520,
+		529,
+		560,
+		562,
+		573,
+		586,
+		598,
+		611,
+		654,
+		659,
+		664,
+		703,
+		717,
+		742,
+		752,
+		771,
+		777,
+		790,
+		802,
+		808,
+		809,
+		838,
+		841,
+		846,
+		854,
+		858,
+		867,
+		870,
+		879,
+		894,
+		895,
+		905,
+		959,
+		983,
+		987,
+		991,
+		1012,
+		1020,
+		1024,
+		1046,
+		1090,
+		1091,
+		1122,
+		1130,
+		1163,
+		1182,
+		1184,
+		1211,
+		1240,
+		1241,
+		1279,
+		1300,
+		1326,
+		1331,
+		1338,
+		1350,
+		1352,
+		1355,
+		1384,
+		1405,
+		1430,
+		1436,
+		1464,
+		1475,
+		1478,
+		1512,
+		1527,
+		1538,
+		1548,
+		1559,
+		1563,
+		1566,
+		1572,
+		1586,
+		1595,
+		1597,
+		1609,
+		1619,
+		1646,
+		1658,
+		1661,
+		1674,
+		1708,
+		1717,
+		1752,
+		1756,
+		1758,
+		1761,
+		1775,
+		1782,
+		1802,
+		1808,
+		1810,
+		1829,
+		1830,
+		1848,
+		1862,
+		1887,
+		1890,
+		1905,
+		1942,
+		1950,
+		1965,
+		1999,
+		2001,
+		2006,
+		2016,
+		2017,
+		2036,
+		2052,
+		2073,
+		2090,
+		2097,
+		2111,
+		2127,
+		2143,
+		2145,
+		2149,
+		2152,
+		2175,
+		2183,
+		2186,
+		2243,
+		2260,
+		2297,
+		2301,
+		2325,
+		2326,
+		2386,
+		2403,
+		2435,
+		2438,
+		2440,
+		2462,
+		2463,
+		2474,
+		2476,
+		2511,
+		2538,
+		2578,
+		2584,
+		2605,
+		2633,
+		2685,
+		2689,
+		2717,
+		2734,
+		2757,
+		2759,
+		2765,
+		2776,
+		2784,
+		2800,
+		2802,
+		2833,
+		2836,
+		2843,
+		2849,
+		2867,
+		2889,
+		2891,
+		2906,
+		2930,
+		2949,
+		2999,
+		3013,
+		3015,
+		3039,
+		3047,
+		3051,
+		3060,
+		3067,
+		3077,
+		3080,
+		3103,
+		3114,
+		3148,
+		3166,
+		3170,
+		3175,
+		3189,
+		3220,
+		3237,
+		3252,
+		3254,
+		3257,
+		3268,
+		3283,
+		3303,
+		3332,
+		3344,
+		3363,
+		3371,
+		3423,
+		3443,
+		3450,
+		3452,
+		3466,
+		3487,
+		3510,
+		3524,
+		3525,
+		3538,
+		3570,
+		3606,
+		3666,
+		3741,
+		3795,
+		3799,
+		3801,
+		3804,
+		3810,
+		3812,
+		3824,
+		3852,
+		3863,
+		3865,
+		3868,
+		3877,
+		3900,
+		3908,
+		3941,
+		3961,
+		3976,
+		3986,
+		3994,
+		4014,
+		4039,
+		4088,
+		4112,
+		4118,
+		4125,
+		4140,
+		4142,
+		4159,
+		4175,
+		4186,
+		4205,
+		4241,
+		4242,
+		4258,
+		4267,
+		4285,
+		4300,
+		4318,
+		4334,
+		4337,
+		4363,
+		4378,
+		4397,
+		4415,
+		4446,
+		4484,
+		4488,
+		4507,
+		4509,
+		4524,
+		4528,
+		4533,
+		4548,
+		4554,
+		4567,
+		4575,
+		4589,
+		4659,
+		4694,
+		4737,
+		4743,
+		4749,
+		4763,
+		4773,
+		4798,
+		4805,
+		4808,
+		4826,
+		4875,
+		4938,
+		4973,
+		5013,
+		5014,
+		5017,
+		5041,
+		5065,
+		5083,
+		5085,
+		5120,
+		5122,
+		5130,
+		5131,
+		5173,
+		5222,
+		5224,
+		5247,
+		5254,
+		5270,
+		5284,
+		5300,
+		5309,
+		5328,
+		5344,
+		5383,
+		5428,
+		5429,
+		5450,
+		5470,
+		5481,
+		5496,
+		5517,
+		5525,
+		5537,
+		5545,
+		5556,
+		5574,
+		5589,
+		5599,
+		5601,
+		5625,
+		5631,
+		5637,
+		5649,
+		5651,
+		5684,
+		5686,
+		5747,
+		5765,
+		5766,
+		5791,
+		5824,
+		5829,
+		5839,
+		5876,
+		5877,
+		5897,
+		5900,
+		5938,
+		5940,
+		5951,
+		5968,
+		5993,
+		6021,
+		6026,
+		6073,
+		6131,
+		6172,
+		6174,
+		6180,
+		6218,
+		6228,
+		6230,
+		6236,
+		6284,
+		6285,
+		6288,
+		6343,
+		6359,
+		6361,
+		6402,
+		6442,
+		6458,
+		6464,
+		6485,
+		6513,
+		6548,
+		6560,
+		6573,
+		6597,
+		6628,
+		6634,
+		6635,
+		6644,
+		6646,
+		6692,
+		6700,
+		6736,
+		6772,
+		6775,
+		6804,
+		6837,
+		6851,
+		6868,
+		6871,
+		6957,
+		6958,
+		6960,
+		6963,
+		6968,
+		6988,
+		7003,
+		7007,
+		7021,
+		7038,
+		7041,
+		7047,
+		7049,
+		7083,
+		7094,
+		7174,
+		7187,
+		7188,
+		7194,
+		7206,
+		7234,
+		7278,
+		7281,
+		7285,
+		7321,
+		7324,
+		7328,
+		7346,
+		7376,
+		7479,
+		7522,
+		7528,
+		7562,
+		7601,
+		7604,
+		7651,
+		7654,
+		7662,
+		7666,
+		7671,
+		7690,
+		7693,
+		7718,
+		7764,
+		7766,
+		7785,
+		7805,
+		7818,
+		7819,
+		7843,
+		7855,
+		7857,
+		7859,
+		7866,
+		7881,
+		7885,
+		7890,
+		7895,
+		7896,
+		7918,
+		7926,
+		7927,
+		7928,
+		7960,
+		7975,
+		7994,
+		7998,
+		8014,
+		8036,
+		8037,
+		8048,
+		8054,
+		8083,
+		8088,
+		8104,
+		8105,
+		8107,
+		8108,
+		8122,
+		8123,
+		8165,
+		8209,
+		8245,
+		8249,
+		8295,
+		8300,
+		8336,
+		8340,
+		8345,
+		8364,
+		8384,
+		8385,
+		8440,
+		8451,
+		8511,
+		8512,
+		8550,
+		8567,
+		8578,
+		8581,
+		8583,
+		8591,
+		8599,
+		8600,
+		8604,
+		8630,
+		8638,
+		8639,
+		8644,
+		8649,
+		8656,
+		8657,
+		8659,
+		8678,
+		8696,
+		8720,
+		8790,
+		8812,
+		8828,
+		8861,
+		8862,
+		8866,
+		8892,
+		8903,
+		8917,
+		8954,
+		8955,
+		8956,
+		8959,
+		8990,
+		9017,
+		9026,
+		9043,
+		9087,
+		9090,
+		9092,
+		9094,
+		9105,
+		9108,
+		9133,
+		9175,
+		9177,
+		9198,
+		9200,
+		9241,
+		9247,
+		9261,
+		9266,
+		9293,
+		9311,
+		9343,
+		9348,
+		9351,
+		9417,
+		9422,
+		9441,
+		9454,
+		9507,
+		9526,
+		9529,
+		9557,
+		9560,
+		9605,
+		9618,
+		9628,
+		9629,
+		9649,
+		9651,
+		9655,
+		9690,
+		9706,
+		9712,
+		9724,
+		9725,
+		9762,
+		9799,
+		9807,
+		9812,
+		9821,
+		9837,
+		9845,
+		9867,
+		9868,
+		9878,
+		9888,
+		9912,
+		9920,
+		9960,
+		9965,
+		9976,
+		9978,
+		9981,
+		9987,
+		9990,
+		10042,
+		10088,
+		10106,
+		10115,
+		10140,
+		10142,
+		10147,
+		10160,
+		10166,
+		10169,
+		10170,
+		10252,
+		10278,
+		10283,
+		10306,
+		10310,
+		10324,
+		10325,
+		10350,
+		10353,
+		10366,
+		10375,
+		10426,
+		10448,
+		10450,
+		10465,
+		10468,
+		10478,
+		10493,
+		10498,
+		10501,
+		10519,
+		10536,
+		10567,
+		10573,
+		10577,
+		10595,
+		10607,
+		10626,
+		10637,
+		10656,
+		10665,
+		10668,
+		10687,
+		10690,
+		10692,
+		10756,
+		10759,
+		10768,
+		10774,
+		10778,
+		10810,
+		10859,
+		10878,
+		10880,
+		10883,
+		10909,
+		10941,
+		10942,
+		10946,
+		10969,
+		10976,
+		10989,
+		10992,
+		11002,
+		11008,
+		11023,
+		11032,
+		11033,
+		11038,
+		11050,
+		11074,
+		11076,
+		11078,
+		11104,
+		11105,
+		11137,
+		11146,
+		11148,
+		11172,
+		11209,
+		11229,
+		11237,
+		11245,
+		11258,
+		11260,
+		11276,
+		11290,
+		11291,
+		11294,
+		11299,
+		11308,
+		11340,
+		11343,
+		11346,
+		11366,
+		11408,
+		11417,
+		11428,
+		11429,
+		11444,
+		11454,
+		11457,
+		11472,
+		11478,
+		11486,
+		11501,
+		11510,
+		11513,
+		11518,
+		11519,
+		11520,
+		11545,
+		11553,
+		11560,
+		11570,
+		11572,
+		11588,
+		11597,
+		11613,
+		11641,
+		11656,
+		11665,
+		11666,
+		11672,
+		11694,
+		11699,
+		11712,
+		11730,
+		11734,
+		11816,
+		11827,
+		11845,
+		11848,
+		11850,
+		11854,
+		11869,
+		11878,
+		11898,
+		11937,
+		11972,
+		11978,
+		11980,
+		12009,
+		12015,
+		12034,
+		12036,
+		12068,
+		12087,
+		12132,
+		12135,
+		12148,
+		12174,
+		12196,
+		12241,
+		12268,
+		12295,
+		12299,
+		12340,
+		12361,
+		12365,
+		12409,
+		12439,
+		12496,
+		12508,
+		12518,
+		12539,
+		12543,
+		12599,
+		12605,
+		12613,
+		12618,
+		12659,
+		12672,
+		12675,
+		12697,
+		12704,
+		12726,
+		12729,
+		12736,
+		12762,
+		12768,
+		12769,
+		12774,
+		12789,
+		12846,
+		12848,
+		12876,
+		12880,
+		12908,
+		12929,
+		12962,
+		12968,
+		12992,
+		12997,
+		12999,
+		13038,
+		13047,
+		13048,
+		13118,
+		13120,
+		13124,
+		13129,
+		13148,
+		13173,
+		13182,
+		13184,
+		13188,
+		13221,
+		13258,
+		13262,
+		13287,
+		13289,
+		13298,
+		13300,
+		13304,
+		13353,
+		13370,
+		13397,
+		13405,
+		13443,
+		13451,
+		13460,
+		13476,
+		13480,
+		13521,
+		13530,
+		13549,
+		13571,
+		13573,
+		13582,
+		13583,
+		13589,
+		13628,
+		13649,
+		13681,
+		13699,
+		13702,
+		13711,
+		13712,
+		13720,
+		13721,
+		13724,
+		13747,
+		13749,
+		13758,
+		13774,
+		13775,
+		13785,
+		13790,
+		13816,
+		13840,
+		13876,
+		13877,
+		13896,
+		13943,
+		13958,
+		13994,
+		14006,
+		14080,
+		14096,
+		14115,
+		14134,
+		14135,
+		14140,
+		14163,
+		14214,
+		14243,
+		14248,
+		14291,
+		14300,
+		14346,
+		14400,
+		14406,
+		14410,
+		14411,
+		14412,
+		14445,
+		14454,
+		14462,
+		14468,
+		14471,
+		14509,
+		14518,
+		14555,
+		14594,
+		14632,
+		14635,
+		14644,
+		14647,
+		14656,
+		14671,
+		14687,
+		14699,
+		14703,
+		14708,
+		14711,
+		14715,
+		14725,
+		14742,
+		14757,
+		14760,
+		14765,
+		14786,
+		14810,
+		14812,
+		14850,
+		14856,
+		14871,
+		14873,
+		14908,
+		14939,
+		14961,
+		14990,
+		14992,
+		15000,
+		15010,
+		15014,
+		15044,
+		15089,
+		15116,
+		15121,
+		15150,
+		15155,
+		15167,
+		15190,
+		15196,
+		15277,
+		15296,
+		15303,
+		15308,
+		15310,
+		15312,
+		15315,
+		15327,
+		15344,
+		15354,
+		15361,
+		15373,
+		15375,
+		15377,
+		15412,
+		15435,
+		15437,
+		15451,
+		15467,
+		15489,
+		15501,
+		15508,
+		15515,
+		15524,
+		15547,
+		15549,
+		15558,
+		15561,
+		15566,
+		15578,
+		15588,
+		15591,
+		15598,
+		15601,
+		15623,
+		15654,
+		15666,
+		15678,
+		15687,
+		15707,
+		15713,
+		15723,
+		15731,
+		15774,
+		15807,
+		15808,
+		15813,
+		15837,
+		15852,
+		15853,
+		15854,
+		15889,
+		15892,
+		15935,
+		15955,
+		15976,
+		15978,
+		15987,
+		16016,
+		16044,
+		16052,
+		16054,
+		16064,
+		16111,
+		16117,
+		16135,
+		16158,
+		16170,
+		16172,
+		16192,
+		16205,
+		16217,
+		16232,
+		16274,
+		16302,
+		16324,
+		16336,
+		16347,
+	}
+}
@@ -0,0 +1,13 @@
+// Code generated by "genintervals --width 4 testdata/results4.csv"; DO NOT EDIT.
+
+package sslfsr
+
+// Intervals4Bits returns a list of known optimum intervals
+func Intervals4Bits() (working []int) {
+	return []int{
+		1,
+		7,
+		11,
+		13,
+	}
+}
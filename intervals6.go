@@ -0,0 +1,24 @@
+// Code generated by "genintervals --width 6 testdata/results6.csv"; DO NOT EDIT.
+
+package sslfsr
+
+// Intervals6Bits returns a list of known optimum intervals
+func Intervals6Bits() (working []int) {
+	return []int{
+		1,
+		10,
+		17,
+		31,
+		35,
+		38,
+		39,
+		40,
+		42,
+		46,
+		47,
+		51,
+		55,
+		58,
+		60,
+	}
+}
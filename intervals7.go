@@ -0,0 +1,43 @@
+// Code generated by "genintervals --width 7 testdata/results7.csv"; DO NOT EDIT.
+
+package sslfsr
+
+// Intervals7Bits returns a list of known optimum intervals
+func Intervals7Bits() (working []int) {
+	return []int{
+		2,
+		4,
+		10,
+		22,
+		24,
+		29,
+		32,
+		38,
+		39,
+		43,
+		47,
+		53,
+		59,
+		60,
+		61,
+		66,
+		67,
+		70,
+		72,
+		74,
+		75,
+		81,
+		90,
+		93,
+		98,
+		99,
+		100,
+		103,
+		110,
+		113,
+		117,
+		121,
+		124,
+		125,
+	}
+}
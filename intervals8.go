@@ -0,0 +1,30 @@
+// Code generated by "genintervals --width 8 testdata/results8.csv"; DO NOT EDIT.
+
+package sslfsr
+
+// Intervals8Bits returns a list of known optimum intervals
+func Intervals8Bits() (working []int) {
+	return []int{
+		1,
+		11,
+		29,
+		63,
+		68,
+		83,
+		104,
+		106,
+		129,
+		134,
+		150,
+		155,
+		170,
+		176,
+		177,
+		192,
+		195,
+		202,
+		225,
+		237,
+		253,
+	}
+}
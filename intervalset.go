@@ -0,0 +1,62 @@
+package sslfsr
+
+import "slices"
+
+// intervalSet answers questions about a table of optimum intervals without allocating, which scanning
+// the slice an Intervals<width>Bits function builds on every call can't do
+type intervalSet struct {
+	bits   []uint64 // bit i is set when interval i is optimal
+	sorted []int
+}
+
+func newIntervalSet(intervals []int) (set intervalSet) {
+	set.sorted = slices.Clone(intervals)
+	slices.Sort(set.sorted)
+
+	size := 0
+	if len(set.sorted) > 0 {
+		size = set.sorted[len(set.sorted)-1]/64 + 1
+	}
+
+	set.bits = make([]uint64, size)
+	for _, interval := range set.sorted {
+		set.bits[interval/64] |= 1 << (interval % 64)
+	}
+
+	return set
+}
+
+func (set intervalSet) contains(interval int) bool {
+	return interval/64 < len(set.bits) && set.bits[interval/64]&(1<<(interval%64)) != 0
+}
+
+func (set intervalSet) count() int {
+	return len(set.sorted)
+}
+
+func (set intervalSet) nth(n int) (interval int, ok bool) {
+	if n < 0 || n >= len(set.sorted) {
+		return 0, false
+	}
+
+	return set.sorted[n], true
+}
+
+// nearest returns the optimum interval closest to interval, the smaller of two equally close ones
+func (set intervalSet) nearest(interval int) (nearest int, ok bool) {
+	if len(set.sorted) == 0 {
+		return 0, false
+	}
+
+	i, _ := slices.BinarySearch(set.sorted, interval)
+	switch {
+	case i == 0:
+		return set.sorted[0], true
+	case i == len(set.sorted):
+		return set.sorted[i-1], true
+	case set.sorted[i]-interval < interval-set.sorted[i-1]:
+		return set.sorted[i], true
+	default:
+		return set.sorted[i-1], true
+	}
+}
@@ -0,0 +1,37 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntervalSet(t *testing.T) {
+	t.Parallel()
+
+	set := newIntervalSet([]int{70, 3, 10})
+
+	assert.True(t, set.contains(3))
+	assert.True(t, set.contains(70))
+	assert.False(t, set.contains(4))
+	assert.False(t, set.contains(1000), "beyond the last interval")
+	assert.Equal(t, 3, set.count())
+
+	nth, ok := set.nth(1)
+	assert.True(t, ok)
+	assert.Equal(t, 10, nth)
+	_, ok = set.nth(3)
+	assert.False(t, ok)
+	_, ok = set.nth(-1)
+	assert.False(t, ok)
+
+	for interval, expected := range map[int]int{0: 3, 3: 3, 6: 3, 7: 10, 40: 10, 41: 70, 500: 70} {
+		nearest, ok := set.nearest(interval)
+		assert.True(t, ok)
+		assert.Equal(t, expected, nearest, "nearest to %d", interval)
+	}
+
+	_, ok = newIntervalSet(nil).nearest(5)
+	assert.False(t, ok)
+	assert.False(t, newIntervalSet(nil).contains(0))
+}
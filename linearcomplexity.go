@@ -0,0 +1,44 @@
+package sslfsr
+
+// LinearComplexity returns the length of the shortest LFSR that generates sequence, a sequence of 0
+// and 1 bits, using the Berlekamp-Massey algorithm. A sequence with linear complexity L is given away
+// by any 2L consecutive bits of it, so a high one is what keeps a generator's output unpredictable.
+func LinearComplexity(sequence []uint8) (complexity int) {
+	n := len(sequence)
+	current := make([]uint8, n+1)  // connection polynomial, current[0] is always 1
+	previous := make([]uint8, n+1) // connection polynomial before the last change in complexity
+	current[0], previous[0] = 1, 1
+	shift := 1 // bits since the last change in complexity
+
+	for i := range n {
+		discrepancy := sequence[i] & 1
+		for j := 1; j <= complexity; j++ {
+			discrepancy ^= current[j] & sequence[i-j]
+		}
+
+		if discrepancy == 0 {
+			shift++
+			continue
+		}
+
+		if 2*complexity <= i {
+			replaced := make([]uint8, n+1)
+			copy(replaced, current)
+			for j := 0; j+shift <= n; j++ {
+				current[j+shift] ^= previous[j]
+			}
+
+			complexity = i + 1 - complexity
+			previous = replaced
+			shift = 1
+		} else {
+			for j := 0; j+shift <= n; j++ {
+				current[j+shift] ^= previous[j]
+			}
+
+			shift++
+		}
+	}
+
+	return complexity
+}
@@ -0,0 +1,26 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinearComplexity(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, LinearComplexity(nil))
+	assert.Equal(t, 0, LinearComplexity([]uint8{0, 0, 0, 0}))
+	assert.Equal(t, 1, LinearComplexity([]uint8{1, 1, 1, 1}))
+	assert.Equal(t, 5, LinearComplexity([]uint8{0, 0, 0, 0, 1}))
+	assert.Equal(t, 2, LinearComplexity([]uint8{0, 1, 0, 1, 0, 1}))
+
+	// the output of a maximal 8 bit LFSR
+	sequence := []uint8{}
+	register := uint8(1)
+	for range 2 * 255 {
+		register = Shift8Bits(register)
+		sequence = append(sequence, register&1)
+	}
+	assert.Equal(t, 8, LinearComplexity(sequence))
+}
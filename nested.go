@@ -0,0 +1,189 @@
+package sslfsr
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"github.com/coreyog/sslfsr/internal/gf2"
+)
+
+// NestedLevel configures one sub-register of a NestedSSLFSR
+type NestedLevel struct {
+	Interval uint64 `json:"interval"` // operations of the level above between each of this level's
+	Shifts   int    `json:"shifts"`   // SubShifts applied to this level's sub-register each time
+}
+
+// NestedSSLFSR generalizes SSLFSR to a stack of nested sub-registers, each the lower half of the one
+// above with that width's DefaultTaps, e.g. 16 -> 8 -> 4 bits. Level 0 is the whole register. Each
+// further level counts the operations of the level above, and after its Interval of them the next
+// operation SubShifts its own sub-register Shifts times instead, unless a deeper level is due. One
+// level with one Shift is an SSLFSR.
+type NestedSSLFSR struct {
+	width    int
+	levels   []NestedLevel
+	register uint64
+	counters []uint64 // counters[i] counts the operations of the level above levels[i]
+}
+
+// NewNestedSSLFSR constructs a NestedSSLFSR of the given width with the given levels
+func NewNestedSSLFSR(width int, levels ...NestedLevel) (sslfsr NestedSSLFSR, err error) {
+	return BuildNestedSSLFSR(width, 1, levels, make([]uint64, len(levels)))
+}
+
+// BuildNestedSSLFSR constructs a NestedSSLFSR of the given width with a given register, levels, and counters
+func BuildNestedSSLFSR(width int, register uint64, levels []NestedLevel, counters []uint64) (sslfsr NestedSSLFSR, err error) {
+	switch {
+	case width < 2 || width > MaxWidth:
+		return sslfsr, fmt.Errorf("width must be between 2 and %d bits, not %d", MaxWidth, width)
+	case bits.Len64(register) > width:
+		return sslfsr, fmt.Errorf("register %d doesn't fit in %d bits", register, width)
+	case len(levels) == 0:
+		return sslfsr, fmt.Errorf("at least one sub-register is needed")
+	case width>>len(levels) < 2:
+		return sslfsr, fmt.Errorf("%d levels halve a %d bit register below 2 bits", len(levels), width)
+	case len(counters) != len(levels):
+		return sslfsr, fmt.Errorf("%d counters for %d levels", len(counters), len(levels))
+	}
+
+	for i, level := range levels {
+		if level.Shifts < 1 {
+			return sslfsr, fmt.Errorf("level %d needs at least 1 shift, not %d", i+1, level.Shifts)
+		}
+	}
+
+	return NestedSSLFSR{
+		width:    width,
+		levels:   slices.Clone(levels),
+		register: register,
+		counters: slices.Clone(counters),
+	}, nil
+}
+
+// GetWidth returns the register width in bits
+func (sslfsr *NestedSSLFSR) GetWidth() int {
+	return sslfsr.width
+}
+
+// GetRegister returns the current register value
+func (sslfsr *NestedSSLFSR) GetRegister() uint64 {
+	return sslfsr.register
+}
+
+// GetLevels returns the levels this NestedSSLFSR was constructed with
+func (sslfsr *NestedSSLFSR) GetLevels() []NestedLevel {
+	return slices.Clone(sslfsr.levels)
+}
+
+// GetCounters returns the current counter of every level
+func (sslfsr *NestedSSLFSR) GetCounters() []uint64 {
+	return slices.Clone(sslfsr.counters)
+}
+
+// Next applies the operation of the deepest level whose counter has reached its Interval, resetting the
+// counters above it, or Shifts when none has
+func (sslfsr *NestedSSLFSR) Next() {
+	for i, level := range sslfsr.levels {
+		if sslfsr.counters[i] < level.Interval {
+			sslfsr.counters[i]++
+			sslfsr.operate(i)
+			return
+		}
+
+		sslfsr.counters[i] = 0
+	}
+
+	sslfsr.operate(len(sslfsr.levels))
+}
+
+// operate Shifts for level 0 and SubShifts the level's sub-register its Shifts times otherwise
+func (sslfsr *NestedSSLFSR) operate(level int) {
+	if level == 0 {
+		sslfsr.Shift()
+		return
+	}
+
+	for range sslfsr.levels[level-1].Shifts {
+		sslfsr.SubShift(level)
+	}
+}
+
+// Shift modifies register by applying a standard LFSR shift to it
+func (sslfsr *NestedSSLFSR) Shift() {
+	sslfsr.register = ShiftWidth(sslfsr.register, sslfsr.width, defaultTaps[sslfsr.width])
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just the sub-register of a level
+func (sslfsr *NestedSSLFSR) SubShift(level int) {
+	subWidth := sslfsr.width >> level
+	sslfsr.register = SubShiftWidth(sslfsr.register, subWidth, defaultTaps[subWidth])
+}
+
+// PassLength returns how many Next() calls it takes for every counter to start over
+func (sslfsr *NestedSSLFSR) PassLength() (calls uint64) {
+	// a pass of level k is the passes of every level above it, then the Shifts, then its operation
+	lengths := make([]uint64, len(sslfsr.levels))
+	for k := range sslfsr.levels {
+		lengths[k] = 1 + sslfsr.levels[0].Interval
+		for j := range k {
+			lengths[k] += sslfsr.levels[j+1].Interval * lengths[j]
+		}
+	}
+
+	return lengths[len(lengths)-1]
+}
+
+// CalculateExpectedMaximalLength calculates the total state count if every non-zero register were
+// reached at the start of a pass
+func (sslfsr *NestedSSLFSR) CalculateExpectedMaximalLength() (stateCount uint64) {
+	return (1<<sslfsr.width - 1) * sslfsr.PassLength()
+}
+
+// VerifyPeriod reports whether Next() takes CalculateExpectedMaximalLength() calls to return to its
+// starting state by proving a pass takes register 1 through every non-zero register, the same way
+// VerifyInterval does for SSLFSR
+func (sslfsr *NestedSSLFSR) VerifyPeriod() bool {
+	return sslfsr.passMap().CycleIs(1, 1<<sslfsr.width-1)
+}
+
+// CalculatePeriod calculates how many Next() calls it takes to return to register 1 with every counter
+// at 0. The counters only all start over together at the end of a pass, so this follows register 1
+// a pass at a time until it comes back around, up to 2^width passes.
+func (sslfsr *NestedSSLFSR) CalculatePeriod() (period uint64) {
+	pass := sslfsr.passMap()
+
+	register := pass.Apply(1)
+	passes := uint64(1)
+	for register != 1 {
+		register = pass.Apply(register)
+		passes++
+	}
+
+	return passes * sslfsr.PassLength()
+}
+
+// passMap is the linear map of a whole pass over GF(2)
+func (sslfsr *NestedSSLFSR) passMap() gf2.Matrix {
+	shift := gf2.FromFunc(sslfsr.width, func(register uint64) uint64 {
+		return ShiftWidth(register, sslfsr.width, defaultTaps[sslfsr.width])
+	})
+	shifts := shift.Pow(sslfsr.levels[0].Interval)
+
+	// a pass of level k is the passes of every level above it, then the Shifts, then its operation
+	passes := make([]gf2.Matrix, len(sslfsr.levels))
+	for k, level := range sslfsr.levels {
+		subWidth := sslfsr.width >> (k + 1)
+		subshift := gf2.FromFunc(sslfsr.width, func(register uint64) uint64 {
+			return SubShiftWidth(register, subWidth, defaultTaps[subWidth])
+		})
+
+		step := gf2.Identity(sslfsr.width)
+		for j := range k {
+			step = step.Mul(passes[j].Pow(sslfsr.levels[j+1].Interval)) // the deepest passes come first
+		}
+
+		passes[k] = subshift.Pow(uint64(level.Shifts)).Mul(shifts).Mul(step)
+	}
+
+	return passes[len(passes)-1]
+}
@@ -0,0 +1,110 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNestedSSLFSRMatchesSSLFSR(t *testing.T) {
+	t.Parallel()
+
+	nested, err := NewNestedSSLFSR(8, NestedLevel{Interval: 11, Shifts: 1})
+	require.NoError(t, err)
+	reg := NewSSLFSR8(11)
+
+	for range 1000 {
+		nested.Next()
+		reg.Next()
+
+		assert.Equal(t, uint64(reg.GetRegister()), nested.GetRegister())
+		assert.Equal(t, []uint64{uint64(reg.GetCounter())}, nested.GetCounters())
+	}
+
+	assert.Equal(t, uint64(CalculateExpectedMaximalLength8Bits(11)), nested.CalculateExpectedMaximalLength())
+	assert.True(t, nested.VerifyPeriod())
+}
+
+func TestNestedSSLFSRPeriod(t *testing.T) {
+	t.Parallel()
+
+	configs := [][]NestedLevel{
+		{{Interval: 2, Shifts: 1}},
+		{{Interval: 11, Shifts: 3}},
+		{{Interval: 0, Shifts: 2}},
+		{{Interval: 3, Shifts: 1}, {Interval: 2, Shifts: 1}},
+		{{Interval: 5, Shifts: 2}, {Interval: 4, Shifts: 2}},
+		{{Interval: 1, Shifts: 1}, {Interval: 0, Shifts: 1}},
+		{{Interval: 1, Shifts: 1}, {Interval: 1, Shifts: 1}, {Interval: 1, Shifts: 1}},
+		{{Interval: 2, Shifts: 3}, {Interval: 3, Shifts: 1}, {Interval: 1, Shifts: 2}},
+	}
+
+	for _, levels := range configs {
+		for _, width := range []int{8, 12, 16} {
+			if width>>len(levels) < 2 {
+				continue
+			}
+
+			reg, err := NewNestedSSLFSR(width, levels...)
+			require.NoError(t, err)
+
+			// walk until the register and every counter start over
+			reg.Next()
+			count := uint64(1)
+			for reg.GetRegister() != 1 || !allZero(reg.GetCounters()) {
+				reg.Next()
+				count++
+			}
+
+			assert.Equal(t, count, reg.CalculatePeriod(), "%d bits, levels %v", width, levels)
+			assert.Equal(t, count == reg.CalculateExpectedMaximalLength(), reg.VerifyPeriod(), "%d bits, levels %v", width, levels)
+		}
+	}
+}
+
+func TestNestedSSLFSRPassLength(t *testing.T) {
+	t.Parallel()
+
+	reg, err := NewNestedSSLFSR(16, NestedLevel{Interval: 3, Shifts: 1}, NestedLevel{Interval: 2, Shifts: 1}, NestedLevel{Interval: 4, Shifts: 1})
+	require.NoError(t, err)
+
+	// level 1 passes are 4 calls, level 2 passes 2*4 + 4 = 12 and level 3 passes 4*12 + 2*4 + 4 = 60
+	assert.Equal(t, uint64(60), reg.PassLength())
+
+	for range 59 {
+		reg.Next()
+		assert.False(t, allZero(reg.GetCounters()))
+	}
+	reg.Next()
+	assert.True(t, allZero(reg.GetCounters()))
+}
+
+func TestBuildNestedSSLFSR(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewNestedSSLFSR(8)
+	assert.Error(t, err, "no levels")
+
+	_, err = NewNestedSSLFSR(8, NestedLevel{Interval: 1, Shifts: 1}, NestedLevel{Interval: 1, Shifts: 1}, NestedLevel{Interval: 1, Shifts: 1})
+	assert.Error(t, err, "8 bits only halve twice")
+
+	_, err = NewNestedSSLFSR(8, NestedLevel{Interval: 1})
+	assert.Error(t, err, "no shifts")
+
+	_, err = BuildNestedSSLFSR(8, 256, []NestedLevel{{Interval: 1, Shifts: 1}}, []uint64{0})
+	assert.Error(t, err, "register too wide")
+
+	_, err = BuildNestedSSLFSR(8, 1, []NestedLevel{{Interval: 1, Shifts: 1}}, nil)
+	assert.Error(t, err, "no counters")
+}
+
+func allZero(counters []uint64) bool {
+	for _, counter := range counters {
+		if counter != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,157 @@
+package sslfsr
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Feedback computes the bit a shift feeds into the top of a register from its current bits, only the
+// lowest bit of the result is used
+type Feedback func(register uint64) uint64
+
+// ShiftFeedback applies a shift with any feedback to a width bit register, ShiftWidth is
+// ShiftFeedback(register, width, LinearANF(taps).Feedback())
+func ShiftFeedback(register uint64, width int, feedback Feedback) (result uint64) {
+	return register>>1 | (feedback(register)&1)<<(width-1)
+}
+
+// SubShiftFeedback applies a shift with any feedback to just the lower subWidth bits of a register,
+// the feedback only sees those bits
+func SubShiftFeedback(register uint64, subWidth int, feedback Feedback) (result uint64) {
+	mask := uint64(1)<<subWidth - 1
+
+	return register&^mask | ShiftFeedback(register&mask, subWidth, feedback)
+}
+
+// NonlinearSSLFSR is an SSLFSR whose Shift and SubShift feed back any function of the register's bits
+// instead of the parity of its taps. With LinearANF feedbacks of the DefaultTaps it's an SSLFSR.
+type NonlinearSSLFSR struct {
+	width       int
+	register    uint64
+	interval    uint64
+	counter     uint64
+	feedback    Feedback // Shift's feedback from the whole register
+	subFeedback Feedback // SubShift's feedback from the lower width/2 bits
+}
+
+// NewNonlinearSSLFSR constructs a NonlinearSSLFSR of the given width with an interval and feedbacks
+func NewNonlinearSSLFSR(width int, interval uint64, feedback Feedback, subFeedback Feedback) (sslfsr NonlinearSSLFSR, err error) {
+	return BuildNonlinearSSLFSR(width, 1, interval, 0, feedback, subFeedback)
+}
+
+// BuildNonlinearSSLFSR constructs a NonlinearSSLFSR of the given width with a given register, interval,
+// counter, and feedbacks
+func BuildNonlinearSSLFSR(width int, register uint64, interval uint64, counter uint64, feedback Feedback, subFeedback Feedback) (sslfsr NonlinearSSLFSR, err error) {
+	switch {
+	case width < 2 || width > MaxWidth:
+		return sslfsr, fmt.Errorf("width must be between 2 and %d bits, not %d", MaxWidth, width)
+	case bits.Len64(register) > width:
+		return sslfsr, fmt.Errorf("register %d doesn't fit in %d bits", register, width)
+	case feedback == nil || subFeedback == nil:
+		return sslfsr, fmt.Errorf("both feedbacks are needed")
+	}
+
+	return NonlinearSSLFSR{
+		width:       width,
+		register:    register,
+		interval:    interval,
+		counter:     counter,
+		feedback:    feedback,
+		subFeedback: subFeedback,
+	}, nil
+}
+
+// GetWidth returns the register width in bits
+func (sslfsr *NonlinearSSLFSR) GetWidth() int {
+	return sslfsr.width
+}
+
+// GetRegister returns the current register value
+func (sslfsr *NonlinearSSLFSR) GetRegister() uint64 {
+	return sslfsr.register
+}
+
+// GetInterval returns the interval this NonlinearSSLFSR was constructed with
+func (sslfsr *NonlinearSSLFSR) GetInterval() uint64 {
+	return sslfsr.interval
+}
+
+// GetCounter returns the current counter value
+func (sslfsr *NonlinearSSLFSR) GetCounter() uint64 {
+	return sslfsr.counter
+}
+
+// Next Shifts or SubShifts according to the Counter and Interval and updates Counter accordingly
+func (sslfsr *NonlinearSSLFSR) Next() {
+	if sslfsr.counter == sslfsr.interval {
+		sslfsr.SubShift()
+		sslfsr.counter = 0
+	} else {
+		sslfsr.Shift()
+		sslfsr.counter++
+	}
+}
+
+// Shift modifies register by applying a shift with the feedback to it
+func (sslfsr *NonlinearSSLFSR) Shift() {
+	sslfsr.register = ShiftFeedback(sslfsr.register, sslfsr.width, sslfsr.feedback)
+}
+
+// SubShift modifies register by applying a shift with the sub feedback to just it's lower bits
+func (sslfsr *NonlinearSSLFSR) SubShift() {
+	sslfsr.register = SubShiftFeedback(sslfsr.register, sslfsr.width/2, sslfsr.subFeedback)
+}
+
+// Bits calls Next() n times and returns the lowest bit of the register after each call, the bit the
+// next Shift drops
+func (sslfsr *NonlinearSSLFSR) Bits(n int) (sequence []uint8) {
+	sequence = make([]uint8, n)
+	for i := range sequence {
+		sslfsr.Next()
+		sequence[i] = uint8(sslfsr.register & 1)
+	}
+
+	return sequence
+}
+
+// NonlinearAnalysis is the cycle structure of a NonlinearSSLFSR. The counter always starts over at the
+// end of a pass of Interval Shifts and a SubShift, so the structure is that of the map a pass applies
+// to the register. Without linear feedback a pass may send two registers to the same one, and 0 may
+// go anywhere.
+type NonlinearAnalysis struct {
+	Width       int    `json:"width"`
+	PassLength  uint64 `json:"pass_length"` // Next() calls in a pass, Interval+1
+	Permutation bool   `json:"permutation"` // no two registers lead to the same one, so every register is on a cycle
+	Cycles      []int  `json:"cycles"`      // passes around every cycle, longest first
+	Tail        int    `json:"tail"`        // passes from register 1 until it's on a cycle, the last one may get there part way through
+	Passes      int    `json:"passes"`      // passes around the cycle register 1 ends up on
+	Period      uint64 `json:"period"`      // Next() calls around that cycle
+}
+
+// Maximal reports whether register 1 is on a cycle through every non-zero register, nonlinear feedback
+// can take it through 0 as well
+func (analysis NonlinearAnalysis) Maximal() bool {
+	return analysis.Tail == 0 && analysis.Passes >= 1<<analysis.Width-1
+}
+
+// Analyze measures the period and cycle structure of a NonlinearSSLFSR with this one's width, interval
+// and feedbacks by following a pass from every register, so it's only practical up to 20 bits or so
+func (sslfsr *NonlinearSSLFSR) Analyze() (analysis NonlinearAnalysis) {
+	passes := analyzeClocking(sslfsr.width, func(register uint64) uint64 {
+		for range sslfsr.interval {
+			register = ShiftFeedback(register, sslfsr.width, sslfsr.feedback)
+		}
+
+		return SubShiftFeedback(register, sslfsr.width/2, sslfsr.subFeedback)
+	})
+
+	return NonlinearAnalysis{
+		Width:       sslfsr.width,
+		PassLength:  sslfsr.interval + 1,
+		Permutation: passes.Permutation,
+		Cycles:      passes.Cycles,
+		Tail:        passes.Tail,
+		Passes:      passes.Period,
+		Period:      uint64(passes.Period) * (sslfsr.interval + 1),
+	}
+}
@@ -0,0 +1,120 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonlinearSSLFSRMatchesSSLFSR8(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range Intervals8Bits()[:10] {
+		expected := NewSSLFSR8(uint8(interval))
+		reg, err := NewNonlinearSSLFSR(8, uint64(interval), LinearANF(Taps8Bits).Feedback(), LinearANF(SubTaps8Bits).Feedback())
+		require.NoError(t, err)
+
+		for range 1000 {
+			expected.Next()
+			reg.Next()
+			require.Equal(t, uint64(expected.GetRegister()), reg.GetRegister(), "interval %d", interval)
+		}
+	}
+}
+
+func TestAnalyzeLinearFeedback(t *testing.T) {
+	t.Parallel()
+
+	for interval := range uint64(15) {
+		reg, err := NewNonlinearSSLFSR(4, interval, LinearANF(Taps4Bits).Feedback(), LinearANF(SubTaps4Bits).Feedback())
+		require.NoError(t, err)
+
+		analysis := reg.Analyze()
+		assert.True(t, analysis.Permutation, "interval %d", interval)
+		assert.Equal(t, IsOptimalInterval4Bits(uint8(interval)), analysis.Maximal(), "interval %d", interval)
+		if analysis.Maximal() {
+			assert.Equal(t, uint64(CalculateExpectedMaximalLength4Bits(uint8(interval))), analysis.Period, "interval %d", interval)
+		}
+	}
+}
+
+func TestAnalyzeNonlinearFeedback(t *testing.T) {
+	t.Parallel()
+
+	subFeedback := LinearANF(SubTaps8Bits).Feedback()
+	feedbacks := []string{"x0 + x2 + x3 + x4 + x1*x5", "x0 + x2*x3 + x4*x6*x7", "x1*x2 + x3"}
+
+	for _, text := range feedbacks {
+		anf, err := ParseANF(text)
+		require.NoError(t, err)
+
+		for _, interval := range []uint64{3, 5, 7} {
+			reg, err := NewNonlinearSSLFSR(8, interval, anf.Feedback(), subFeedback)
+			require.NoError(t, err)
+			analysis := reg.Analyze()
+
+			// walk from register 1 until a register and counter come around again
+			type state struct{ register, counter uint64 }
+			seen := map[state]uint64{}
+			for calls := uint64(0); ; calls++ {
+				s := state{reg.GetRegister(), reg.GetCounter()}
+				if first, ok := seen[s]; ok {
+					// a tail can end part way through a pass, but only in the pass the analysis says it does
+					tail := uint64(analysis.Tail) * analysis.PassLength
+					assert.LessOrEqual(t, first, tail, "%s interval %d", text, interval)
+					assert.Greater(t, first+analysis.PassLength, tail, "%s interval %d", text, interval)
+					assert.Equal(t, analysis.Period, calls-first, "%s interval %d", text, interval)
+					break
+				}
+
+				seen[s] = calls
+				reg.Next()
+			}
+		}
+	}
+
+	// x1*x2 + x3 ignores the bit a shift drops, so two registers can lead to the same one
+	anf, _ := ParseANF("x1*x2 + x3")
+	reg, err := NewNonlinearSSLFSR(8, 3, anf.Feedback(), subFeedback)
+	require.NoError(t, err)
+	assert.False(t, reg.Analyze().Permutation)
+}
+
+func TestNonlinearLinearComplexity(t *testing.T) {
+	t.Parallel()
+
+	// every Interval+1th bit of a linear SSLFSR8 comes from one linear map, so 8 bits of state each
+	linear, err := NewNonlinearSSLFSR(8, 3, LinearANF(Taps8Bits).Feedback(), LinearANF(SubTaps8Bits).Feedback())
+	require.NoError(t, err)
+	period := linear.Analyze().Period
+	assert.LessOrEqual(t, LinearComplexity(linear.Bits(2*int(period))), 8*4)
+
+	anf, err := ParseANF("x0 + x2 + x3 + x4 + x1*x5")
+	require.NoError(t, err)
+	nonlinear, err := NewNonlinearSSLFSR(8, 3, anf.Feedback(), LinearANF(SubTaps8Bits).Feedback())
+	require.NoError(t, err)
+	period = nonlinear.Analyze().Period
+	assert.Equal(t, uint64(928), period)
+	assert.Equal(t, 928, LinearComplexity(nonlinear.Bits(2*int(period))))
+}
+
+func TestBuildNonlinearSSLFSR(t *testing.T) {
+	t.Parallel()
+
+	feedback := LinearANF(Taps8Bits).Feedback()
+
+	reg, err := BuildNonlinearSSLFSR(8, 0x5A, 7, 3, feedback, feedback)
+	require.NoError(t, err)
+	assert.Equal(t, 8, reg.GetWidth())
+	assert.Equal(t, uint64(0x5A), reg.GetRegister())
+	assert.Equal(t, uint64(7), reg.GetInterval())
+	assert.Equal(t, uint64(3), reg.GetCounter())
+
+	_, err = NewNonlinearSSLFSR(1, 7, feedback, feedback)
+	assert.Error(t, err)
+	_, err = BuildNonlinearSSLFSR(4, 0x10, 7, 0, feedback, feedback)
+	assert.Error(t, err)
+	_, err = NewNonlinearSSLFSR(8, 7, feedback, nil)
+	assert.Error(t, err)
+}
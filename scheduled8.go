@@ -0,0 +1,137 @@
+package sslfsr
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// ScheduledSSLFSR8 holds an 8 bit register driven by a schedule of intervals instead of one. It
+// Shifts and SubShifts for the first interval of the schedule, then the second, and so on, starting
+// over once the schedule runs out.
+type ScheduledSSLFSR8 struct {
+	register uint8
+	schedule []uint8
+	step     int // index of the schedule's interval in use
+	counter  uint8
+}
+
+// NewScheduledSSLFSR8 constructs a ScheduledSSLFSR8 with a given schedule
+func NewScheduledSSLFSR8(schedule ...uint8) (sslfsr ScheduledSSLFSR8, err error) {
+	return BuildScheduledSSLFSR8(1, schedule, 0, 0)
+}
+
+// BuildScheduledSSLFSR8 constructs a ScheduledSSLFSR8 with a given register, schedule, step, and counter
+func BuildScheduledSSLFSR8(register uint8, schedule []uint8, step int, counter uint8) (sslfsr ScheduledSSLFSR8, err error) {
+	if len(schedule) == 0 {
+		return sslfsr, fmt.Errorf("a schedule needs at least one interval")
+	}
+
+	if step < 0 || step >= len(schedule) {
+		return sslfsr, fmt.Errorf("step %d isn't in a schedule of %d intervals", step, len(schedule))
+	}
+
+	return ScheduledSSLFSR8{
+		register: register,
+		schedule: slices.Clone(schedule),
+		step:     step,
+		counter:  counter,
+	}, nil
+}
+
+// GetRegister returns the current register value
+func (sslfsr *ScheduledSSLFSR8) GetRegister() uint8 {
+	return sslfsr.register
+}
+
+// GetSchedule returns the schedule this ScheduledSSLFSR8 was constructed with
+func (sslfsr *ScheduledSSLFSR8) GetSchedule() []uint8 {
+	return slices.Clone(sslfsr.schedule)
+}
+
+// GetStep returns the index of the schedule's interval in use
+func (sslfsr *ScheduledSSLFSR8) GetStep() int {
+	return sslfsr.step
+}
+
+// GetCounter returns the current counter value
+func (sslfsr *ScheduledSSLFSR8) GetCounter() uint8 {
+	return sslfsr.counter
+}
+
+// Next Shifts or SubShifts according to the Counter and the schedule's current Interval, moving on to
+// the next Interval after each SubShift
+func (sslfsr *ScheduledSSLFSR8) Next() {
+	if sslfsr.counter == sslfsr.schedule[sslfsr.step] {
+		sslfsr.SubShift()
+		sslfsr.counter = 0
+		sslfsr.step = (sslfsr.step + 1) % len(sslfsr.schedule)
+	} else {
+		sslfsr.Shift()
+		sslfsr.counter++
+	}
+}
+
+// Shift modifies register by applying a standard LFSR shift to it, see Shift8Bits
+func (sslfsr *ScheduledSSLFSR8) Shift() {
+	sslfsr.register = Shift8Bits(sslfsr.register)
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just it's lower bits, see SubShift8Bits
+func (sslfsr *ScheduledSSLFSR8) SubShift() {
+	sslfsr.register = SubShift8Bits(sslfsr.register)
+}
+
+// CalculateExpectedMaximalLength calculates the total state count if the schedule were an optimal schedule
+func (sslfsr *ScheduledSSLFSR8) CalculateExpectedMaximalLength() (stateCount int) {
+	return CalculateExpectedScheduleMaximalLength8Bits(sslfsr.schedule)
+}
+
+// CalculateExpectedScheduleMaximalLength8Bits calculates the total state count if schedule were an
+// optimal schedule, every non-zero register once for each pass through the schedule
+func CalculateExpectedScheduleMaximalLength8Bits(schedule []uint8) (stateCount int) {
+	return math.MaxUint8 * schedulePassLength(schedule)
+}
+
+// CalculatePeriod calculates how many Next() calls a ScheduledSSLFSR8 built with this schedule takes to
+// return to register 1 at the start of the schedule, see CalculateSchedulePeriod8Bits
+func (sslfsr *ScheduledSSLFSR8) CalculatePeriod() (period int) {
+	return CalculateSchedulePeriod8Bits(sslfsr.schedule)
+}
+
+// CalculateSchedulePeriod8Bits calculates how many Next() calls NewScheduledSSLFSR8(schedule...) takes
+// to return to its starting state. The counter and step only start over together at the end of a
+// pass through the schedule, so this counts the passes until register 1 comes back around.
+func CalculateSchedulePeriod8Bits(schedule []uint8) (period int) {
+	register := uint8(1)
+	passes := 0
+
+	for {
+		for _, interval := range schedule {
+			for range interval {
+				register = Shift8Bits(register)
+			}
+			register = SubShift8Bits(register)
+		}
+		passes++
+
+		if register == 1 {
+			return passes * schedulePassLength(schedule)
+		}
+	}
+}
+
+// IsOptimalSchedule8Bits reports whether schedule takes a ScheduledSSLFSR8 through every non-zero
+// register at the start of a pass, the longest period a schedule can give
+func IsOptimalSchedule8Bits(schedule []uint8) bool {
+	return len(schedule) > 0 && CalculateSchedulePeriod8Bits(schedule) == CalculateExpectedScheduleMaximalLength8Bits(schedule)
+}
+
+// schedulePassLength is the number of Next() calls one pass through schedule takes
+func schedulePassLength(schedule []uint8) (calls int) {
+	for _, interval := range schedule {
+		calls += int(interval) + 1
+	}
+
+	return calls
+}
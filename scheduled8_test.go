@@ -0,0 +1,73 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledSSLFSR8MatchesSSLFSR8(t *testing.T) {
+	t.Parallel()
+
+	scheduled, err := NewScheduledSSLFSR8(29)
+	require.NoError(t, err)
+	reg := NewSSLFSR8(29)
+
+	for range 1000 {
+		scheduled.Next()
+		reg.Next()
+
+		assert.Equal(t, reg.GetRegister(), scheduled.GetRegister())
+		assert.Equal(t, reg.GetCounter(), scheduled.GetCounter())
+	}
+}
+
+func TestSchedulePeriod8Bits(t *testing.T) {
+	t.Parallel()
+
+	for _, schedule := range [][]uint8{{11}, {2}, {11, 29}, {2, 3}, {22, 61, 114}, {1, 1, 2}} {
+		reg, err := NewScheduledSSLFSR8(schedule...)
+		require.NoError(t, err)
+
+		reg.Next()
+		count := 1
+		for reg.GetRegister() != 1 || reg.GetStep() != 0 || reg.GetCounter() != 0 {
+			reg.Next()
+			count++
+		}
+
+		assert.Equal(t, count, reg.CalculatePeriod(), "schedule %v", schedule)
+		assert.Equal(t, count == reg.CalculateExpectedMaximalLength(), IsOptimalSchedule8Bits(schedule), "schedule %v", schedule)
+	}
+}
+
+func TestIsOptimalSchedule8Bits(t *testing.T) {
+	t.Parallel()
+
+	for interval := range uint8(255) {
+		assert.Equal(t, IsOptimalInterval8Bits(interval), IsOptimalSchedule8Bits([]uint8{interval}), "interval %d", interval)
+	}
+
+	assert.False(t, IsOptimalSchedule8Bits(nil))
+}
+
+func TestBuildScheduledSSLFSR8(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewScheduledSSLFSR8()
+	assert.Error(t, err)
+
+	_, err = BuildScheduledSSLFSR8(1, []uint8{11, 29}, 2, 0)
+	assert.Error(t, err)
+
+	schedule := []uint8{11, 29}
+	reg, err := BuildScheduledSSLFSR8(5, schedule, 1, 3)
+	require.NoError(t, err)
+	schedule[0] = 0
+
+	assert.Equal(t, uint8(5), reg.GetRegister())
+	assert.Equal(t, []uint8{11, 29}, reg.GetSchedule(), "the schedule is copied")
+	assert.Equal(t, 1, reg.GetStep())
+	assert.Equal(t, uint8(3), reg.GetCounter())
+}
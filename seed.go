@@ -0,0 +1,14 @@
+package sslfsr
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// seedWords derives two independent words from arbitrary seed bytes, one for a register and one for
+// an interval, so equal seeds always build equal generators
+func seedWords(seed []byte) (register uint64, interval uint64) {
+	sum := sha256.Sum256(seed)
+
+	return binary.LittleEndian.Uint64(sum[:8]), binary.LittleEndian.Uint64(sum[8:16])
+}
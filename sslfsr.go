@@ -0,0 +1,172 @@
+package sslfsr
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// MaxWidth is the widest register SSLFSR supports
+const MaxWidth = 24
+
+// defaultTaps holds primitive taps for every width up to MaxWidth, the fewest taps that give a maximal
+// cycle except where a fixed width SSLFSR already settled on its own
+var defaultTaps = [MaxWidth + 1]uint64{
+	1:  0b1,
+	2:  0b11,
+	3:  0b011,
+	4:  Taps4Bits,
+	5:  0b00101,
+	6:  0b000011,
+	7:  0b0000011,
+	8:  Taps8Bits,
+	9:  0b000010001,
+	10: 0b0000001001,
+	11: 0b00000000101,
+	12: 0b000001010011,
+	13: 0b0000000011011,
+	14: 0b00000000101011,
+	15: 0b000000000000011,
+	16: Taps16Bits,
+	17: 0b00000000000001001,
+	18: 0b000000000010000001,
+	19: 0b0000000000000100111,
+	20: 0b00000000000000001001,
+	21: 0b000000000000000000101,
+	22: 0b0000000000000000000011,
+	23: 0b00000000000000000100001,
+	24: 0b000000000000000000011011,
+}
+
+// DefaultTaps returns the taps SSLFSR shifts a width bit register with, and the taps it shifts the
+// lower width/2 bits with, which are the default taps of that narrower width
+func DefaultTaps(width int) (taps uint64, subTaps uint64, ok bool) {
+	if width < 2 || width > MaxWidth {
+		return 0, 0, false
+	}
+
+	return defaultTaps[width], defaultTaps[width/2], true
+}
+
+// KnownIntervals returns the known optimum intervals for a width with DefaultTaps
+func KnownIntervals(width int) (intervals []int, ok bool) {
+	switch width {
+	case 4:
+		return Intervals4Bits(), true
+	case 5:
+		return Intervals5Bits(), true
+	case 6:
+		return Intervals6Bits(), true
+	case 7:
+		return Intervals7Bits(), true
+	case 8:
+		return Intervals8Bits(), true
+	case 10:
+		return Intervals10Bits(), true
+	case 12:
+		return Intervals12Bits(), true
+	case 14:
+		return Intervals14Bits(), true
+	case 16:
+		return Intervals16Bits(), true
+	default:
+		return nil, false
+	}
+}
+
+// SSLFSR manages a register of any width from 2 to MaxWidth bits, shifted with DefaultTaps. The fixed
+// width types are faster.
+type SSLFSR struct {
+	width    int
+	taps     uint64
+	subTaps  uint64
+	register uint64
+	interval uint64
+	counter  uint64
+}
+
+// NewSSLFSR constructs an SSLFSR of the given width with a given interval
+func NewSSLFSR(width int, interval uint64) (sslfsr SSLFSR, err error) {
+	return BuildSSLFSR(width, 1, interval, 0)
+}
+
+// BuildSSLFSR constructs an SSLFSR of the given width with a given register, interval, and counter
+func BuildSSLFSR(width int, register uint64, interval uint64, counter uint64) (sslfsr SSLFSR, err error) {
+	taps, subTaps, ok := DefaultTaps(width)
+	if !ok {
+		return sslfsr, fmt.Errorf("width must be between 2 and %d bits, not %d", MaxWidth, width)
+	}
+
+	if bits.Len64(register) > width {
+		return sslfsr, fmt.Errorf("register %d doesn't fit in %d bits", register, width)
+	}
+
+	return SSLFSR{
+		width:    width,
+		taps:     taps,
+		subTaps:  subTaps,
+		register: register,
+		interval: interval,
+		counter:  counter,
+	}, nil
+}
+
+// GetWidth returns the register width in bits
+func (sslfsr *SSLFSR) GetWidth() int {
+	return sslfsr.width
+}
+
+// GetRegister returns the current register value
+func (sslfsr *SSLFSR) GetRegister() uint64 {
+	return sslfsr.register
+}
+
+// GetInterval returns the interval this SSLFSR was constructed with
+func (sslfsr *SSLFSR) GetInterval() uint64 {
+	return sslfsr.interval
+}
+
+// GetCounter returns the current counter value
+func (sslfsr *SSLFSR) GetCounter() uint64 {
+	return sslfsr.counter
+}
+
+// Next Shifts or SubShifts according to the Counter and Interval and updates Counter accordingly
+func (sslfsr *SSLFSR) Next() {
+	if sslfsr.counter == sslfsr.interval {
+		sslfsr.SubShift()
+		sslfsr.counter = 0
+	} else {
+		sslfsr.Shift()
+		sslfsr.counter++
+	}
+}
+
+// Shift modifies register by applying a standard LFSR shift to it
+func (sslfsr *SSLFSR) Shift() {
+	sslfsr.register = ShiftWidth(sslfsr.register, sslfsr.width, sslfsr.taps)
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just it's lower bits
+func (sslfsr *SSLFSR) SubShift() {
+	sslfsr.register = SubShiftWidth(sslfsr.register, sslfsr.width/2, sslfsr.subTaps)
+}
+
+// CalculateExpectedMaximalLength calculates the total state count if the SSLFSRs Interval were an optimal Interval
+func (sslfsr *SSLFSR) CalculateExpectedMaximalLength() (stateCount uint64) {
+	return (1<<sslfsr.width - 1) * (sslfsr.interval + 1)
+}
+
+// VerifyInterval reports whether the SSLFSRs Interval is an optimal Interval by proving Next() takes
+// CalculateExpectedMaximalLength() calls to return to its starting state, without making them
+func (sslfsr *SSLFSR) VerifyInterval() bool {
+	width, taps, subTaps := sslfsr.width, sslfsr.taps, sslfsr.subTaps
+
+	shift := func(register uint64) uint64 {
+		return ShiftWidth(register, width, taps)
+	}
+	subshift := func(register uint64) uint64 {
+		return SubShiftWidth(register, width/2, subTaps)
+	}
+
+	return verifyInterval(width, shift, subshift, sslfsr.interval)
+}
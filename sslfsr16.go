@@ -3,8 +3,15 @@ package sslfsr
 import (
 	"math"
 	"math/bits"
+	"sort"
 )
 
+// Taps16Bits are the feedback taps Shift16Bits applies to the whole register
+const Taps16Bits = 0b00010000_00001011
+
+// SubTaps16Bits are the feedback taps SubShift16Bits applies to the lower 8 bits
+const SubTaps16Bits = 0b00000000_00011101
+
 // SSLFSR16 holds a 16 bit register
 type SSLFSR16 struct {
 	register uint16
@@ -12,2465 +19,6 @@ type SSLFSR16 struct {
 	counter  uint16
 }
 
-// Intervals16Bits returns a list of known optimum intervals
-func Intervals16Bits() []int {
-	return []int{
-		22,
-		24,
-		61,
-		62,
-		66,
-		90,
-		114,
-		157,
-		167,
-		194,
-		201,
-		224,
-		237,
-		249,
-		252,
-		293,
-		320,
-		325,
-		348,
-		368,
-		389,
-		422,
-		450,
-		479,
-		492,
-		498,
-		543,
-		562,
-		564,
-		629,
-		640,
-		733,
-		782,
-		785,
-		829,
-		843,
-		847,
-		856,
-		913,
-		916,
-		925,
-		981,
-		1064,
-		1091,
-		1101,
-		1162,
-		1172,
-		1218,
-		1225,
-		1265,
-		1350,
-		1357,
-		1394,
-		1402,
-		1436,
-		1444,
-		1463,
-		1523,
-		1539,
-		1552,
-		1555,
-		1561,
-		1581,
-		1589,
-		1594,
-		1670,
-		1688,
-		1746,
-		1752,
-		1798,
-		1802,
-		1837,
-		1848,
-		1854,
-		1858,
-		1901,
-		1910,
-		1917,
-		1936,
-		1952,
-		1993,
-		2030,
-		2149,
-		2152,
-		2166,
-		2169,
-		2218,
-		2220,
-		2285,
-		2315,
-		2346,
-		2371,
-		2378,
-		2399,
-		2401,
-		2407,
-		2430,
-		2443,
-		2451,
-		2482,
-		2540,
-		2558,
-		2561,
-		2565,
-		2583,
-		2599,
-		2629,
-		2648,
-		2680,
-		2797,
-		2829,
-		2859,
-		2867,
-		2888,
-		2922,
-		2957,
-		2983,
-		3166,
-		3189,
-		3224,
-		3260,
-		3371,
-		3372,
-		3393,
-		3400,
-		3409,
-		3412,
-		3438,
-		3445,
-		3471,
-		3503,
-		3509,
-		3524,
-		3526,
-		3634,
-		3637,
-		3700,
-		3786,
-		3820,
-		3861,
-		3887,
-		3921,
-		3958,
-		3969,
-		3973,
-		3982,
-		4008,
-		4023,
-		4033,
-		4059,
-		4071,
-		4085,
-		4096,
-		4128,
-		4155,
-		4158,
-		4195,
-		4209,
-		4223,
-		4256,
-		4304,
-		4362,
-		4444,
-		4498,
-		4520,
-		4546,
-		4548,
-		4550,
-		4557,
-		4586,
-		4623,
-		4630,
-		4662,
-		4663,
-		4666,
-		4747,
-		4758,
-		4772,
-		4822,
-		4835,
-		4950,
-		4991,
-		5000,
-		5016,
-		5043,
-		5055,
-		5067,
-		5097,
-		5099,
-		5113,
-		5145,
-		5172,
-		5180,
-		5199,
-		5244,
-		5256,
-		5268,
-		5280,
-		5322,
-		5339,
-		5344,
-		5351,
-		5373,
-		5383,
-		5387,
-		5420,
-		5443,
-		5484,
-		5486,
-		5511,
-		5582,
-		5595,
-		5599,
-		5608,
-		5613,
-		5623,
-		5641,
-		5647,
-		5658,
-		5704,
-		5732,
-		5750,
-		5821,
-		5842,
-		5861,
-		5916,
-		5918,
-		5937,
-		5948,
-		5991,
-		6023,
-		6029,
-		6035,
-		6055,
-		6072,
-		6154,
-		6201,
-		6217,
-		6253,
-		6257,
-		6262,
-		6276,
-		6310,
-		6330,
-		6349,
-		6457,
-		6473,
-		6495,
-		6517,
-		6531,
-		6548,
-		6664,
-		6679,
-		6707,
-		6721,
-		6746,
-		6818,
-		6823,
-		6842,
-		6865,
-		6901,
-		6905,
-		6956,
-		7020,
-		7045,
-		7047,
-		7059,
-		7067,
-		7120,
-		7123,
-		7159,
-		7229,
-		7242,
-		7244,
-		7260,
-		7348,
-		7350,
-		7404,
-		7423,
-		7436,
-		7479,
-		7494,
-		7507,
-		7579,
-		7587,
-		7590,
-		7636,
-		7648,
-		7651,
-		7655,
-		7694,
-		7698,
-		7701,
-		7728,
-		7839,
-		7929,
-		7934,
-		7935,
-		7964,
-		8045,
-		8071,
-		8082,
-		8122,
-		8124,
-		8186,
-		8210,
-		8214,
-		8301,
-		8402,
-		8414,
-		8425,
-		8430,
-		8521,
-		8599,
-		8639,
-		8720,
-		8726,
-		8780,
-		8788,
-		8811,
-		8829,
-		8851,
-		8932,
-		8971,
-		9065,
-		9075,
-		9087,
-		9109,
-		9114,
-		9153,
-		9154,
-		9166,
-		9300,
-		9309,
-		9330,
-		9371,
-		9397,
-		9398,
-		9418,
-		9458,
-		9506,
-		9522,
-		9527,
-		9535,
-		9549,
-		9606,
-		9625,
-		9684,
-		9765,
-		9775,
-		9807,
-		9825,
-		9830,
-		9890,
-		9916,
-		9942,
-		9947,
-		9968,
-		9972,
-		9980,
-		9983,
-		9998,
-		10034,
-		10044,
-		10122,
-		10171,
-		10202,
-		10213,
-		10291,
-		10320,
-		10353,
-		10408,
-		10441,
-		10460,
-		10472,
-		10606,
-		10616,
-		10623,
-		10661,
-		10668,
-		10677,
-		10723,
-		10732,
-		10757,
-		10769,
-		10774,
-		10797,
-		10818,
-		10855,
-		10872,
-		10874,
-		10910,
-		10914,
-		10922,
-		10925,
-		11003,
-		11010,
-		11041,
-		11046,
-		11063,
-		11071,
-		11122,
-		11147,
-		11211,
-		11347,
-		11356,
-		11370,
-		11434,
-		11450,
-		11617,
-		11623,
-		11640,
-		11642,
-		11676,
-		11691,
-		11808,
-		11820,
-		11856,
-		11861,
-		11864,
-		11890,
-		11950,
-		11977,
-		11993,
-		12011,
-		12015,
-		12046,
-		12092,
-		12160,
-		12175,
-		12191,
-		12297,
-		12301,
-		12331,
-		12354,
-		12364,
-		12380,
-		12381,
-		12394,
-		12413,
-		12416,
-		12435,
-		12477,
-		12498,
-		12516,
-		12522,
-		12542,
-		12600,
-		12606,
-		12610,
-		12622,
-		12645,
-		12652,
-		12670,
-		12671,
-		12677,
-		12759,
-		12820,
-		12827,
-		12855,
-		12877,
-		12903,
-		12932,
-		12940,
-		13015,
-		13029,
-		13045,
-		13096,
-		13125,
-		13134,
-		13173,
-		13216,
-		13253,
-		13254,
-		13261,
-		13298,
-		13321,
-		13323,
-		13325,
-		13340,
-		13360,
-		13400,
-		13401,
-		13412,
-		13419,
-		13437,
-		13457,
-		13496,
-		13505,
-		13511,
-		13512,
-		13526,
-		13559,
-		13567,
-		13569,
-		13641,
-		13657,
-		13701,
-		13725,
-		13742,
-		13759,
-		13766,
-		13784,
-		13830,
-		13835,
-		13842,
-		13848,
-		13920,
-		13925,
-		13951,
-		13956,
-		13960,
-		13993,
-		14012,
-		14013,
-		14021,
-		14026,
-		14105,
-		14122,
-		14151,
-		14169,
-		14172,
-		14179,
-		14187,
-		14214,
-		14250,
-		14261,
-		14276,
-		14277,
-		14283,
-		14301,
-		14313,
-		14332,
-		14383,
-		14454,
-		14457,
-		14483,
-		14486,
-		14511,
-		14526,
-		14560,
-		14563,
-		14584,
-		14600,
-		14601,
-		14626,
-		14682,
-		14692,
-		14701,
-		14705,
-		14730,
-		14736,
-		14774,
-		14777,
-		14783,
-		14785,
-		14798,
-		14801,
-		14823,
-		14882,
-		14894,
-		14930,
-		14943,
-		14948,
-		14952,
-		14964,
-		14972,
-		15114,
-		15141,
-		15168,
-		15176,
-		15186,
-		15237,
-		15273,
-		15284,
-		15300,
-		15318,
-		15376,
-		15377,
-		15434,
-		15454,
-		15499,
-		15514,
-		15521,
-		15556,
-		15596,
-		15610,
-		15613,
-		15615,
-		15647,
-		15695,
-		15698,
-		15727,
-		15731,
-		15763,
-		15771,
-		15772,
-		15774,
-		15813,
-		15832,
-		15838,
-		15851,
-		15854,
-		15866,
-		15877,
-		15927,
-		15931,
-		15945,
-		15962,
-		15980,
-		16025,
-		16028,
-		16057,
-		16157,
-		16186,
-		16255,
-		16259,
-		16261,
-		16309,
-		16333,
-		16353,
-		16358,
-		16372,
-		16387,
-		16392,
-		16407,
-		16409,
-		16433,
-		16444,
-		16467,
-		16491,
-		16503,
-		16504,
-		16508,
-		16547,
-		16569,
-		16585,
-		16602,
-		16622,
-		16633,
-		16642,
-		16683,
-		16757,
-		16769,
-		16770,
-		16798,
-		16808,
-		16834,
-		16842,
-		16897,
-		16928,
-		16968,
-		16975,
-		16977,
-		16985,
-		17003,
-		17004,
-		17007,
-		17023,
-		17050,
-		17078,
-		17119,
-		17159,
-		17180,
-		17194,
-		17203,
-		17225,
-		17289,
-		17302,
-		17355,
-		17382,
-		17426,
-		17445,
-		17477,
-		17502,
-		17545,
-		17548,
-		17596,
-		17599,
-		17761,
-		17775,
-		17779,
-		17819,
-		17823,
-		17834,
-		17835,
-		17858,
-		17888,
-		17960,
-		17966,
-		18012,
-		18028,
-		18075,
-		18085,
-		18117,
-		18132,
-		18139,
-		18175,
-		18190,
-		18201,
-		18209,
-		18241,
-		18264,
-		18292,
-		18297,
-		18300,
-		18308,
-		18309,
-		18322,
-		18340,
-		18377,
-		18393,
-		18426,
-		18451,
-		18485,
-		18494,
-		18496,
-		18523,
-		18531,
-		18673,
-		18675,
-		18687,
-		18725,
-		18738,
-		18871,
-		18882,
-		18912,
-		18926,
-		18927,
-		18958,
-		18997,
-		19035,
-		19044,
-		19052,
-		19073,
-		19126,
-		19131,
-		19163,
-		19169,
-		19183,
-		19189,
-		19192,
-		19205,
-		19250,
-		19263,
-		19267,
-		19292,
-		19356,
-		19361,
-		19374,
-		19452,
-		19455,
-		19466,
-		19482,
-		19496,
-		19519,
-		19527,
-		19528,
-		19539,
-		19568,
-		19603,
-		19643,
-		19649,
-		19666,
-		19674,
-		19700,
-		19715,
-		19725,
-		19759,
-		19853,
-		19869,
-		19876,
-		19904,
-		19911,
-		19921,
-		19926,
-		19939,
-		19979,
-		19991,
-		19996,
-		20011,
-		20024,
-		20027,
-		20112,
-		20130,
-		20177,
-		20210,
-		20239,
-		20263,
-		20283,
-		20289,
-		20331,
-		20355,
-		20356,
-		20378,
-		20401,
-		20410,
-		20420,
-		20454,
-		20456,
-		20522,
-		20523,
-		20568,
-		20613,
-		20646,
-		20677,
-		20690,
-		20732,
-		20825,
-		20896,
-		20899,
-		20918,
-		20958,
-		20972,
-		20974,
-		20993,
-		21022,
-		21049,
-		21067,
-		21137,
-		21174,
-		21209,
-		21230,
-		21249,
-		21281,
-		21294,
-		21330,
-		21348,
-		21372,
-		21440,
-		21451,
-		21452,
-		21472,
-		21475,
-		21486,
-		21504,
-		21536,
-		21562,
-		21586,
-		21611,
-		21612,
-		21622,
-		21680,
-		21684,
-		21722,
-		21734,
-		21806,
-		21848,
-		21859,
-		21918,
-		21921,
-		22033,
-		22041,
-		22052,
-		22061,
-		22066,
-		22068,
-		22198,
-		22203,
-		22245,
-		22269,
-		22296,
-		22299,
-		22322,
-		22342,
-		22385,
-		22388,
-		22391,
-		22393,
-		22397,
-		22421,
-		22444,
-		22446,
-		22459,
-		22479,
-		22505,
-		22512,
-		22514,
-		22554,
-		22612,
-		22621,
-		22651,
-		22716,
-		22743,
-		22870,
-		22946,
-		22956,
-		22966,
-		22969,
-		22970,
-		22986,
-		22994,
-		23003,
-		23004,
-		23006,
-		23031,
-		23044,
-		23077,
-		23098,
-		23137,
-		23175,
-		23179,
-		23217,
-		23232,
-		23246,
-		23269,
-		23315,
-		23395,
-		23417,
-		23427,
-		23437,
-		23453,
-		23525,
-		23596,
-		23601,
-		23623,
-		23670,
-		23700,
-		23707,
-		23726,
-		23728,
-		23761,
-		23772,
-		23778,
-		23796,
-		23813,
-		23830,
-		23858,
-		23889,
-		23899,
-		23900,
-		23934,
-		23972,
-		23990,
-		24010,
-		24015,
-		24021,
-		24023,
-		24065,
-		24104,
-		24151,
-		24159,
-		24164,
-		24213,
-		24307,
-		24352,
-		24370,
-		24381,
-		24427,
-		24441,
-		24474,
-		24484,
-		24494,
-		24505,
-		24512,
-		24561,
-		24563,
-		24593,
-		24611,
-		24658,
-		24661,
-		24685,
-		24706,
-		24760,
-		24769,
-		24803,
-		24816,
-		24874,
-		24898,
-		24968,
-		24995,
-		25089,
-		25092,
-		25099,
-		25134,
-		25161,
-		25170,
-		25256,
-		25257,
-		25289,
-		25344,
-		25453,
-		25503,
-		25531,
-		25566,
-		25603,
-		25640,
-		25662,
-		25673,
-		25691,
-		25732,
-		25736,
-		25750,
-		25758,
-		25778,
-		25781,
-		25789,
-		25792,
-		25801,
-		25811,
-		25842,
-		25878,
-		25920,
-		25949,
-		25969,
-		25985,
-		25992,
-		25993,
-		25997,
-		26006,
-		26154,
-		26174,
-		26231,
-		26243,
-		26286,
-		26322,
-		26469,
-		26471,
-		26529,
-		26602,
-		26603,
-		26616,
-		26639,
-		26647,
-		26694,
-		26697,
-		26735,
-		26753,
-		26765,
-		26769,
-		26824,
-		26876,
-		26932,
-		26938,
-		26944,
-		26973,
-		26995,
-		27024,
-		27063,
-		27072,
-		27098,
-		27101,
-		27110,
-		27142,
-		27144,
-		27174,
-		27266,
-		27273,
-		27277,
-		27278,
-		27317,
-		27320,
-		27371,
-		27375,
-		27378,
-		27412,
-		27426,
-		27446,
-		27450,
-		27459,
-		27514,
-		27540,
-		27573,
-		27576,
-		27610,
-		27679,
-		27687,
-		27693,
-		27696,
-		27704,
-		27708,
-		27714,
-		27735,
-		27775,
-		27840,
-		27914,
-		27945,
-		27950,
-		27967,
-		27970,
-		27991,
-		28014,
-		28063,
-		28105,
-		28157,
-		28257,
-		28296,
-		28314,
-		28318,
-		28352,
-		28353,
-		28370,
-		28400,
-		28407,
-		28447,
-		28448,
-		28518,
-		28524,
-		28596,
-		28618,
-		28620,
-		28624,
-		28631,
-		28760,
-		28801,
-		28805,
-		28814,
-		28815,
-		28869,
-		28871,
-		28903,
-		28924,
-		28952,
-		28963,
-		28983,
-		29049,
-		29052,
-		29072,
-		29101,
-		29155,
-		29163,
-		29167,
-		29235,
-		29258,
-		29291,
-		29321,
-		29334,
-		29350,
-		29383,
-		29388,
-		29427,
-		29431,
-		29460,
-		29464,
-		29502,
-		29509,
-		29535,
-		29573,
-		29590,
-		29592,
-		29606,
-		29626,
-		29669,
-		29695,
-		29745,
-		29751,
-		29753,
-		29774,
-		29800,
-		29855,
-		29943,
-		29953,
-		30013,
-		30069,
-		30115,
-		30143,
-		30145,
-		30169,
-		30178,
-		30207,
-		30218,
-		30221,
-		30286,
-		30297,
-		30307,
-		30309,
-		30319,
-		30323,
-		30333,
-		30336,
-		30424,
-		30438,
-		30450,
-		30470,
-		30595,
-		30639,
-		30657,
-		30714,
-		30718,
-		30745,
-		30771,
-		30834,
-		30837,
-		30841,
-		30887,
-		30897,
-		30919,
-		30920,
-		30974,
-		31010,
-		31020,
-		31029,
-		31038,
-		31041,
-		31049,
-		31101,
-		31140,
-		31146,
-		31172,
-		31195,
-		31210,
-		31227,
-		31233,
-		31278,
-		31350,
-		31366,
-		31372,
-		31377,
-		31460,
-		31495,
-		31496,
-		31719,
-		31757,
-		31763,
-		31771,
-		31779,
-		31784,
-		31801,
-		31802,
-		31809,
-		31843,
-		31852,
-		31868,
-		31928,
-		31936,
-		31973,
-		32000,
-		32003,
-		32017,
-		32039,
-		32099,
-		32120,
-		32132,
-		32139,
-		32143,
-		32287,
-		32323,
-		32341,
-		32373,
-		32394,
-		32395,
-		32459,
-		32497,
-		32502,
-		32511,
-		32516,
-		32550,
-		32630,
-		32682,
-		32689,
-		32735,
-		32769,
-		32798,
-		32853,
-		32870,
-		32874,
-		32890,
-		32908,
-		32924,
-		32935,
-		32990,
-		32991,
-		33000,
-		33050,
-		33064,
-		33090,
-		33102,
-		33114,
-		33124,
-		33180,
-		33181,
-		33274,
-		33281,
-		33304,
-		33377,
-		33385,
-		33391,
-		33395,
-		33416,
-		33479,
-		33484,
-		33486,
-		33587,
-		33591,
-		33643,
-		33654,
-		33690,
-		33765,
-		33849,
-		33902,
-		33908,
-		33958,
-		33982,
-		33986,
-		34004,
-		34022,
-		34036,
-		34042,
-		34119,
-		34158,
-		34171,
-		34206,
-		34227,
-		34282,
-		34297,
-		34299,
-		34342,
-		34359,
-		34371,
-		34396,
-		34452,
-		34453,
-		34492,
-		34565,
-		34567,
-		34590,
-		34607,
-		34627,
-		34667,
-		34700,
-		34709,
-		34712,
-		34718,
-		34742,
-		34800,
-		34806,
-		34863,
-		34868,
-		34872,
-		34946,
-		34971,
-		35031,
-		35087,
-		35109,
-		35151,
-		35209,
-		35251,
-		35262,
-		35269,
-		35320,
-		35359,
-		35374,
-		35400,
-		35405,
-		35409,
-		35463,
-		35515,
-		35570,
-		35577,
-		35592,
-		35648,
-		35674,
-		35692,
-		35701,
-		35743,
-		35749,
-		35768,
-		35852,
-		35856,
-		35877,
-		35962,
-		35974,
-		35988,
-		36003,
-		36011,
-		36090,
-		36107,
-		36124,
-		36125,
-		36135,
-		36141,
-		36173,
-		36281,
-		36290,
-		36305,
-		36314,
-		36316,
-		36336,
-		36383,
-		36397,
-		36406,
-		36407,
-		36410,
-		36416,
-		36427,
-		36429,
-		36532,
-		36557,
-		36565,
-		36616,
-		36631,
-		36635,
-		36647,
-		36700,
-		36712,
-		36726,
-		36760,
-		36761,
-		36787,
-		36870,
-		36898,
-		37015,
-		37040,
-		37088,
-		37183,
-		37234,
-		37279,
-		37293,
-		37296,
-		37310,
-		37338,
-		37346,
-		37357,
-		37389,
-		37409,
-		37410,
-		37481,
-		37493,
-		37533,
-		37591,
-		37603,
-		37607,
-		37632,
-		37643,
-		37665,
-		37674,
-		37684,
-		37704,
-		37728,
-		37736,
-		37738,
-		37759,
-		37763,
-		37767,
-		37768,
-		37778,
-		37815,
-		37830,
-		37873,
-		37902,
-		38015,
-		38032,
-		38036,
-		38064,
-		38072,
-		38114,
-		38144,
-		38153,
-		38170,
-		38194,
-		38200,
-		38206,
-		38215,
-		38254,
-		38278,
-		38292,
-		38318,
-		38327,
-		38372,
-		38399,
-		38410,
-		38455,
-		38471,
-		38495,
-		38529,
-		38535,
-		38548,
-		38551,
-		38578,
-		38581,
-		38608,
-		38655,
-		38668,
-		38673,
-		38707,
-		38736,
-		38763,
-		38821,
-		38839,
-		38842,
-		38865,
-		38897,
-		38923,
-		38943,
-		38951,
-		38988,
-		38992,
-		38993,
-		38996,
-		39019,
-		39040,
-		39041,
-		39045,
-		39089,
-		39111,
-		39151,
-		39208,
-		39212,
-		39263,
-		39289,
-		39291,
-		39301,
-		39321,
-		39324,
-		39370,
-		39428,
-		39441,
-		39443,
-		39460,
-		39476,
-		39485,
-		39491,
-		39499,
-		39525,
-		39528,
-		39586,
-		39665,
-		39693,
-		39704,
-		39721,
-		39754,
-		39759,
-		39895,
-		39903,
-		39948,
-		39971,
-		39977,
-		39992,
-		40009,
-		40014,
-		40028,
-		40054,
-		40064,
-		40096,
-		40123,
-		40126,
-		40136,
-		40149,
-		40151,
-		40186,
-		40211,
-		40218,
-		40299,
-		40321,
-		40330,
-		40383,
-		40388,
-		40395,
-		40407,
-		40415,
-		40417,
-		40450,
-		40451,
-		40590,
-		40623,
-		40696,
-		40698,
-		40734,
-		40747,
-		40766,
-		40792,
-		40813,
-		40815,
-		40820,
-		40829,
-		40839,
-		40853,
-		40862,
-		40890,
-		40975,
-		40984,
-		40987,
-		41005,
-		41028,
-		41042,
-		41051,
-		41080,
-		41132,
-		41135,
-		41158,
-		41191,
-		41223,
-		41225,
-		41229,
-		41231,
-		41266,
-		41268,
-		41313,
-		41358,
-		41370,
-		41375,
-		41402,
-		41418,
-		41424,
-		41434,
-		41468,
-		41469,
-		41472,
-		41474,
-		41477,
-		41625,
-		41663,
-		41709,
-		41732,
-		41735,
-		41748,
-		41770,
-		41785,
-		41792,
-		41832,
-		41841,
-		41860,
-		41879,
-		41903,
-		41907,
-		41948,
-		42011,
-		42092,
-		42117,
-		42139,
-		42140,
-		42146,
-		42202,
-		42234,
-		42265,
-		42303,
-		42324,
-		42344,
-		42381,
-		42413,
-		42419,
-		42480,
-		42495,
-		42513,
-		42528,
-		42552,
-		42553,
-		42564,
-		42580,
-		42591,
-		42602,
-		42618,
-		42677,
-		42690,
-		42796,
-		42836,
-		42837,
-		42859,
-		42907,
-		42933,
-		42962,
-		42969,
-		42970,
-		42977,
-		42992,
-		43003,
-		43064,
-		43074,
-		43154,
-		43207,
-		43305,
-		43348,
-		43353,
-		43394,
-		43403,
-		43416,
-		43456,
-		43459,
-		43509,
-		43525,
-		43569,
-		43576,
-		43582,
-		43591,
-		43630,
-		43678,
-		43690,
-		43764,
-		43767,
-		43808,
-		43812,
-		43843,
-		43893,
-		43895,
-		43897,
-		43905,
-		43915,
-		43950,
-		43953,
-		43957,
-		43960,
-		44021,
-		44061,
-		44064,
-		44067,
-		44122,
-		44166,
-		44176,
-		44213,
-		44240,
-		44249,
-		44273,
-		44300,
-		44325,
-		44340,
-		44347,
-		44394,
-		44424,
-		44446,
-		44512,
-		44525,
-		44629,
-		44643,
-		44672,
-		44676,
-		44795,
-		44814,
-		44832,
-		44834,
-		44945,
-		44967,
-		45077,
-		45079,
-		45166,
-		45209,
-		45212,
-		45253,
-		45264,
-		45299,
-		45377,
-		45492,
-		45505,
-		45541,
-		45573,
-		45600,
-		45619,
-		45626,
-		45649,
-		45706,
-		45720,
-		45757,
-		45764,
-		45771,
-		45780,
-		45786,
-		45815,
-		45828,
-		45850,
-		45867,
-		45928,
-		46007,
-		46028,
-		46046,
-		46050,
-		46129,
-		46142,
-		46173,
-		46205,
-		46217,
-		46252,
-		46261,
-		46269,
-		46279,
-		46290,
-		46323,
-		46341,
-		46360,
-		46369,
-		46390,
-		46396,
-		46417,
-		46422,
-		46433,
-		46443,
-		46465,
-		46469,
-		46470,
-		46493,
-		46503,
-		46524,
-		46556,
-		46561,
-		46593,
-		46657,
-		46717,
-		46753,
-		46830,
-		46860,
-		46862,
-		46875,
-		46900,
-		46964,
-		46981,
-		46989,
-		47014,
-		47034,
-		47047,
-		47097,
-		47104,
-		47120,
-		47124,
-		47127,
-		47192,
-		47202,
-		47218,
-		47317,
-		47371,
-		47425,
-		47459,
-		47460,
-		47464,
-		47484,
-		47490,
-		47493,
-		47566,
-		47594,
-		47687,
-		47735,
-		47751,
-		47777,
-		47790,
-		47803,
-		47814,
-		47877,
-		47885,
-		47952,
-		47981,
-		48001,
-		48004,
-		48005,
-		48055,
-		48094,
-		48101,
-		48103,
-		48104,
-		48111,
-		48117,
-		48122,
-		48179,
-		48187,
-		48190,
-		48206,
-		48225,
-		48302,
-		48357,
-		48361,
-		48390,
-		48432,
-		48461,
-		48492,
-		48581,
-		48608,
-		48633,
-		48654,
-		48684,
-		48703,
-		48770,
-		48786,
-		48814,
-		48874,
-		48911,
-		48927,
-		48993,
-		49016,
-		49053,
-		49075,
-		49156,
-		49184,
-		49193,
-		49196,
-		49220,
-		49235,
-		49253,
-		49298,
-		49325,
-		49339,
-		49342,
-		49381,
-		49424,
-		49480,
-		49500,
-		49536,
-		49596,
-		49651,
-		49660,
-		49665,
-		49667,
-		49708,
-		49710,
-		49712,
-		49717,
-		49723,
-		49755,
-		49837,
-		49863,
-		49945,
-		49954,
-		49971,
-		49973,
-		49996,
-		50124,
-		50142,
-		50164,
-		50185,
-		50212,
-		50320,
-		50327,
-		50333,
-		50356,
-		50368,
-		50374,
-		50383,
-		50393,
-		50423,
-		50463,
-		50467,
-		50478,
-		50504,
-		50517,
-		50536,
-		50610,
-		50802,
-		50824,
-		50825,
-		50853,
-		50854,
-		50869,
-		50874,
-		50893,
-		50911,
-		50941,
-		51016,
-		51041,
-		51098,
-		51102,
-		51110,
-		51117,
-		51164,
-		51166,
-		51178,
-		51183,
-		51186,
-		51221,
-		51232,
-		51308,
-		51334,
-		51379,
-		51395,
-		51443,
-		51447,
-		51485,
-		51489,
-		51502,
-		51529,
-		51534,
-		51567,
-		51599,
-		51637,
-		51642,
-		51646,
-		51647,
-		51700,
-		51715,
-		51752,
-		51759,
-		51760,
-		51769,
-		51781,
-		51800,
-		51857,
-		51863,
-		51880,
-		51932,
-		51999,
-		52018,
-		52069,
-		52098,
-		52107,
-		52120,
-		52136,
-		52196,
-		52242,
-		52244,
-		52284,
-		52293,
-		52299,
-		52377,
-		52382,
-		52387,
-		52459,
-		52511,
-		52532,
-		52542,
-		52563,
-		52605,
-		52631,
-		52677,
-		52837,
-		52843,
-		52927,
-		52988,
-		53017,
-		53026,
-		53035,
-		53053,
-		53068,
-		53135,
-		53137,
-		53177,
-		53185,
-		53196,
-		53219,
-		53252,
-		53261,
-		53282,
-		53287,
-		53301,
-		53366,
-		53436,
-		53474,
-		53507,
-		53518,
-		53531,
-		53576,
-		53657,
-		53660,
-		53683,
-		53694,
-		53708,
-		53776,
-		53780,
-		53785,
-		53824,
-		53829,
-		53879,
-		53881,
-		53889,
-		53907,
-		53915,
-		53972,
-		54000,
-		54042,
-		54100,
-		54172,
-		54175,
-		54191,
-		54195,
-		54220,
-		54388,
-		54398,
-		54480,
-		54497,
-		54522,
-		54538,
-		54548,
-		54591,
-		54641,
-		54682,
-		54732,
-		54733,
-		54739,
-		54751,
-		54764,
-		54802,
-		54817,
-		54841,
-		54874,
-		54878,
-		54884,
-		54885,
-		54943,
-		54944,
-		54953,
-		54973,
-		54974,
-		55021,
-		55032,
-		55041,
-		55056,
-		55069,
-		55091,
-		55092,
-		55118,
-		55130,
-		55171,
-		55196,
-		55200,
-		55239,
-		55275,
-		55284,
-		55290,
-		55308,
-		55321,
-		55331,
-		55380,
-		55393,
-		55398,
-		55435,
-		55437,
-		55444,
-		55474,
-		55509,
-		55511,
-		55517,
-		55524,
-		55589,
-		55606,
-		55668,
-		55690,
-		55748,
-		55782,
-		55834,
-		55894,
-		55930,
-		55946,
-		56009,
-		56018,
-		56037,
-		56054,
-		56124,
-		56141,
-		56145,
-		56152,
-		56160,
-		56221,
-		56251,
-		56273,
-		56334,
-		56351,
-		56389,
-		56432,
-		56465,
-		56470,
-		56473,
-		56490,
-		56500,
-		56506,
-		56508,
-		56525,
-		56552,
-		56556,
-		56562,
-		56583,
-		56593,
-		56614,
-		56617,
-		56628,
-		56654,
-		56674,
-		56677,
-		56685,
-		56697,
-		56760,
-		56763,
-		56806,
-		56834,
-		56836,
-		56861,
-		56872,
-		56881,
-		56916,
-		56933,
-		56957,
-		56967,
-		56986,
-		57001,
-		57007,
-		57019,
-		57045,
-		57047,
-		57063,
-		57066,
-		57067,
-		57089,
-		57100,
-		57115,
-		57122,
-		57147,
-		57200,
-		57206,
-		57224,
-		57260,
-		57279,
-		57328,
-		57332,
-		57354,
-		57361,
-		57412,
-		57430,
-		57442,
-		57451,
-		57483,
-		57491,
-		57503,
-		57513,
-		57530,
-		57562,
-		57619,
-		57634,
-		57704,
-		57786,
-		57789,
-		57811,
-		57821,
-		57863,
-		57901,
-		57910,
-		57967,
-		57978,
-		57991,
-		57994,
-		58003,
-		58092,
-		58099,
-		58153,
-		58165,
-		58167,
-		58210,
-		58274,
-		58321,
-		58333,
-		58384,
-		58403,
-		58416,
-		58461,
-		58463,
-		58468,
-		58493,
-		58507,
-		58533,
-		58652,
-		58656,
-		58669,
-		58729,
-		58735,
-		58763,
-		58821,
-		58897,
-		58907,
-		58979,
-		59010,
-		59018,
-		59050,
-		59083,
-		59107,
-		59133,
-		59182,
-		59194,
-		59197,
-		59198,
-		59218,
-		59254,
-		59262,
-		59276,
-		59339,
-		59410,
-		59434,
-		59441,
-		59449,
-		59492,
-		59520,
-		59574,
-		59578,
-		59587,
-		59596,
-		59644,
-		59658,
-		59666,
-		59708,
-		59771,
-		59815,
-		59841,
-		59869,
-		59902,
-		59966,
-		60151,
-		60160,
-		60251,
-		60253,
-		60260,
-		60277,
-		60285,
-		60311,
-		60315,
-		60316,
-		60334,
-		60368,
-		60393,
-		60411,
-		60424,
-		60432,
-		60449,
-		60462,
-		60497,
-		60584,
-		60635,
-		60665,
-		60774,
-		60783,
-		60796,
-		60810,
-		60830,
-		60835,
-		60837,
-		60847,
-		60921,
-		60953,
-		61018,
-		61042,
-		61072,
-		61082,
-		61087,
-		61112,
-		61130,
-		61184,
-		61398,
-		61444,
-		61512,
-		61563,
-		61598,
-		61632,
-		61660,
-		61663,
-		61667,
-		61675,
-		61719,
-		61825,
-		61832,
-		61838,
-		61865,
-		61884,
-		61892,
-		61939,
-		61946,
-		61970,
-		62001,
-		62112,
-		62131,
-		62139,
-		62141,
-		62234,
-		62329,
-		62340,
-		62341,
-		62370,
-		62421,
-		62436,
-		62475,
-		62522,
-		62596,
-		62612,
-		62623,
-		62701,
-		62716,
-		62835,
-		62836,
-		62862,
-		62977,
-		63003,
-		63015,
-		63021,
-		63028,
-		63037,
-		63115,
-		63116,
-		63118,
-		63126,
-		63127,
-		63146,
-		63187,
-		63257,
-		63316,
-		63322,
-		63357,
-		63369,
-		63474,
-		63476,
-		63491,
-		63518,
-		63544,
-		63553,
-		63579,
-		63586,
-		63596,
-		63604,
-		63619,
-		63630,
-		63671,
-		63704,
-		63712,
-		63713,
-		63717,
-		63728,
-		63738,
-		63827,
-		63829,
-		63847,
-		63852,
-		63854,
-		63856,
-		63894,
-		63910,
-		63924,
-		63960,
-		63978,
-		64026,
-		64087,
-		64092,
-		64140,
-		64154,
-		64246,
-		64264,
-		64291,
-		64301,
-		64302,
-		64305,
-		64335,
-		64350,
-		64391,
-		64395,
-		64442,
-		64483,
-		64505,
-		64525,
-		64537,
-		64552,
-		64582,
-		64583,
-		64689,
-		64707,
-		64765,
-		64799,
-		64830,
-		64884,
-		64885,
-		64910,
-		64911,
-		64931,
-		64977,
-		65078,
-		65085,
-		65106,
-		65112,
-		65142,
-		65173,
-		65184,
-		65194,
-		65245,
-		65261,
-		65302,
-		65448,
-		65462,
-		65500,
-	}
-}
-
 // NewSSLFSR8 constructs an SSLFSR8 with a given interval
 func NewSSLFSR16(interval uint16) (sslfsr SSLFSR16) {
 	return SSLFSR16{
@@ -2513,7 +61,7 @@ func (sslfsr *SSLFSR16) Shift() {
 
 // Shift modifies register by applying a standard LFSR shift to it
 func Shift16Bits(register uint16) (result uint16) {
-	taps := uint16(0b00010000_00001011)
+	taps := uint16(Taps16Bits)
 
 	bit := bits.OnesCount16(register&taps)%2 == 1
 
@@ -2532,7 +80,7 @@ func (sslfsr *SSLFSR16) SubShift() {
 
 // SubShift modifies register by applying a standard LFSR shift to just it's lower bits
 func SubShift16Bits(register uint16) (result uint16) {
-	taps := uint16(0b00000000_00011101)
+	taps := uint16(SubTaps16Bits)
 
 	bit := bits.OnesCount16(register&taps)%2 == 1
 
@@ -2556,3 +104,81 @@ func (sslfsr *SSLFSR16) CalculateExpectedMaximalLength() (stateCount int) {
 func CalculateExpectedMaximalLength16Bits(interval uint16) (stateCount int) {
 	return math.MaxUint16 * (int(interval) + 1)
 }
+
+// VerifyInterval reports whether the SSLFSRs Interval is an optimal Interval, see VerifyInterval16Bits
+func (sslfsr *SSLFSR16) VerifyInterval() bool {
+	return VerifyInterval16Bits(sslfsr.interval)
+}
+
+// VerifyInterval16Bits reports whether interval is an optimal Interval by proving Next() takes
+// CalculateExpectedMaximalLength16Bits(interval) calls to return to its starting state, without making them
+func VerifyInterval16Bits(interval uint16) bool {
+	shift := func(register uint64) uint64 {
+		return uint64(Shift16Bits(uint16(register)))
+	}
+	subshift := func(register uint64) uint64 {
+		return uint64(SubShift16Bits(uint16(register)))
+	}
+
+	return verifyInterval(16, shift, subshift, uint64(interval))
+}
+
+var optimalIntervals16Bits = newIntervalSet(Intervals16Bits())
+
+// IsOptimalInterval16Bits reports whether interval is one of Intervals16Bits without allocating
+func IsOptimalInterval16Bits(interval uint16) bool {
+	return optimalIntervals16Bits.contains(int(interval))
+}
+
+// CountOptimalIntervals16Bits returns how many intervals Intervals16Bits lists
+func CountOptimalIntervals16Bits() int {
+	return optimalIntervals16Bits.count()
+}
+
+// NthOptimalInterval16Bits returns the nth smallest of Intervals16Bits counting from 0, ok is false
+// when there are n or fewer
+func NthOptimalInterval16Bits(n int) (interval uint16, ok bool) {
+	nth, ok := optimalIntervals16Bits.nth(n)
+
+	return uint16(nth), ok
+}
+
+// NearestOptimalInterval16Bits returns the interval of Intervals16Bits closest to interval, the
+// smaller of two equally close ones
+func NearestOptimalInterval16Bits(interval uint16) uint16 {
+	nearest, _ := optimalIntervals16Bits.nearest(int(interval))
+
+	return uint16(nearest)
+}
+
+// ChooseInterval16Bits returns the smallest interval of Intervals16Bits whose period,
+// CalculateExpectedMaximalLength16Bits, is at least minPeriod. ok is false when none is that long.
+func ChooseInterval16Bits(minPeriod int) (interval uint16, ok bool) {
+	n := sort.Search(CountOptimalIntervals16Bits(), func(n int) bool {
+		interval, _ := NthOptimalInterval16Bits(n)
+		return CalculateExpectedMaximalLength16Bits(interval) >= minPeriod
+	})
+
+	return NthOptimalInterval16Bits(n)
+}
+
+// IntervalFromSeed16Bits derives one of Intervals16Bits from arbitrary seed bytes, the same seed always
+// giving the same interval
+func IntervalFromSeed16Bits(seed []byte) (interval uint16) {
+	_, word := seedWords(seed)
+	interval, _ = NthOptimalInterval16Bits(int(word % uint64(CountOptimalIntervals16Bits())))
+
+	return interval
+}
+
+// NewSSLFSR16FromSeed constructs an SSLFSR16 whose register and interval are derived from arbitrary
+// seed bytes. The register is never 0, which would never change, and the interval is always optimal.
+func NewSSLFSR16FromSeed(seed []byte) (sslfsr SSLFSR16) {
+	register, _ := seedWords(seed)
+
+	return SSLFSR16{
+		register: uint16(register%math.MaxUint16 + 1),
+		interval: IntervalFromSeed16Bits(seed),
+		counter:  0,
+	}
+}
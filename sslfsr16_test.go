@@ -3,6 +3,7 @@ package sslfsr
 import (
 	"math"
 	"slices"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -37,41 +38,164 @@ func Test16BitSubShift(t *testing.T) {
 }
 
 func Test16BitIntervals(t *testing.T) {
-	t.Skip("This test takes too long to run")
+	t.Parallel()
 
 	for _, interval := range Intervals16Bits() {
-		reg := NewSSLFSR16(uint16(interval))
-		start := reg.register
+		assert.True(t, VerifyInterval16Bits(uint16(interval)), "should return to start state in ((2^16)-1)(%d+1) state changes", interval)
+	}
+}
 
+func TestNonIntervals16Bits(t *testing.T) {
+	t.Parallel()
+
+	intervals := Intervals16Bits()
+	for i := 1; i < math.MaxUint16; i++ {
+		if slices.Contains(intervals, i) {
+			continue
+		}
+
+		assert.False(t, VerifyInterval16Bits(uint16(i)), "interval %d", i)
+	}
+}
+
+// walk16Bits counts the calls to Next() it takes to come back to the starting state
+func walk16Bits(interval uint16) (count int) {
+	reg := NewSSLFSR16(interval)
+	start := reg.register
+
+	reg.Next()
+	count = 1
+	for reg.register != start || reg.counter != 0 {
 		reg.Next()
-		count := 1
-		for reg.register != start || reg.counter != 0 {
-			reg.Next()
-			count++
+		count++
+	}
+
+	return count
+}
+
+func Test16BitIntervalsByWalking(t *testing.T) {
+	t.Parallel()
+
+	// walking every interval takes too long, the intervals below 100 are a sample
+	for _, interval := range Intervals16Bits() {
+		if interval >= 100 {
+			break
 		}
 
-		assert.Equal(t, reg.CalculateExpectedMaximalLength(), count, "should return to start state in ((2^16)-1)(%d+1) state changes", interval)
+		assert.Equal(t, CalculateExpectedMaximalLength16Bits(uint16(interval)), walk16Bits(uint16(interval)), "should return to start state in ((2^16)-1)(%d+1) state changes", interval)
 	}
 }
 
-func TestNonIntervals16Bits(t *testing.T) {
-	t.Skip("This test takes too long to run")
+func TestNonIntervals16BitsByWalking(t *testing.T) {
+	t.Parallel()
 
 	intervals := Intervals16Bits()
-	for i := 2; i <= math.MaxUint16; i++ {
+	for i := 2; i < 100; i++ {
 		if slices.Contains(intervals, i) {
 			continue
 		}
 
-		reg := NewSSLFSR16(uint16(i))
-		start := reg.register
-		reg.Next()
-		count := 1
-		for reg.register != start || reg.counter != 0 {
-			reg.Next()
-			count++
+		assert.NotEqual(t, CalculateExpectedMaximalLength16Bits(uint16(i)), walk16Bits(uint16(i)), "interval %d", i)
+	}
+}
+
+func TestOptimalIntervalLookups16Bits(t *testing.T) {
+	t.Parallel()
+
+	intervals := Intervals16Bits()
+	assert.Equal(t, len(intervals), CountOptimalIntervals16Bits())
+
+	for n, expected := range intervals {
+		interval, ok := NthOptimalInterval16Bits(n)
+		assert.True(t, ok)
+		assert.Equal(t, uint16(expected), interval)
+	}
+	_, ok := NthOptimalInterval16Bits(len(intervals))
+	assert.False(t, ok)
+
+	for i := range math.MaxUint16 + 1 {
+		above := sort.SearchInts(intervals, i)
+		assert.Equal(t, above < len(intervals) && intervals[above] == i, IsOptimalInterval16Bits(uint16(i)), "interval %d", i)
+
+		// the closer of the intervals either side of i, the smaller when they're as close
+		expected := intervals[min(above, len(intervals)-1)]
+		if above > 0 && (above == len(intervals) || i-intervals[above-1] <= intervals[above]-i) {
+			expected = intervals[above-1]
 		}
+		assert.Equal(t, uint16(expected), NearestOptimalInterval16Bits(uint16(i)), "interval %d", i)
+	}
+}
 
-		assert.NotEqual(t, reg.CalculateExpectedMaximalLength(), count)
+func BenchmarkIsOptimalInterval16Bits(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		_ = IsOptimalInterval16Bits(uint16(i))
 	}
 }
+
+func BenchmarkNearestOptimalInterval16Bits(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		_ = NearestOptimalInterval16Bits(uint16(i))
+	}
+}
+
+func TestOptimalIntervalLookupsDontAllocate16Bits(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = IsOptimalInterval16Bits(22)
+		_ = CountOptimalIntervals16Bits()
+		_, _ = NthOptimalInterval16Bits(100)
+		_ = NearestOptimalInterval16Bits(30000)
+	})
+
+	assert.Zero(t, allocs)
+}
+
+func TestChooseInterval16Bits(t *testing.T) {
+	t.Parallel()
+
+	intervals := Intervals16Bits()
+
+	interval, ok := ChooseInterval16Bits(0)
+	assert.True(t, ok)
+	assert.Equal(t, uint16(intervals[0]), interval)
+
+	for n, expected := range intervals {
+		period := CalculateExpectedMaximalLength16Bits(uint16(expected))
+
+		interval, ok := ChooseInterval16Bits(period)
+		assert.True(t, ok)
+		assert.Equal(t, uint16(expected), interval, "a period of exactly %d", period)
+
+		interval, ok = ChooseInterval16Bits(period + 1)
+		if n+1 < len(intervals) {
+			assert.True(t, ok)
+			assert.Equal(t, uint16(intervals[n+1]), interval, "a period over %d", period)
+		} else {
+			assert.False(t, ok, "no interval is longer than the last")
+		}
+	}
+}
+
+func TestNewSSLFSR16FromSeed(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, NewSSLFSR16FromSeed([]byte("key")), NewSSLFSR16FromSeed([]byte("key")), "seeds are deterministic")
+
+	registers := map[uint16]bool{}
+	for i := range 2000 {
+		seed := []byte{byte(i), byte(i >> 8)}
+		reg := NewSSLFSR16FromSeed(seed)
+
+		assert.NotZero(t, reg.GetRegister())
+		assert.Zero(t, reg.GetCounter())
+		assert.True(t, IsOptimalInterval16Bits(reg.GetInterval()), "interval %d", reg.GetInterval())
+		assert.Equal(t, IntervalFromSeed16Bits(seed), reg.GetInterval())
+
+		registers[reg.GetRegister()] = true
+	}
+
+	assert.Greater(t, len(registers), min(math.MaxUint16, 2000)/2, "registers are spread out")
+}
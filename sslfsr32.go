@@ -0,0 +1,133 @@
+package sslfsr
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Taps32Bits are the feedback taps Shift32Bits applies to the whole register
+const Taps32Bits = 0b00000000_01000000_00000000_00000111
+
+// SubTaps32Bits are the feedback taps SubShift32Bits applies to the lower 16 bits
+const SubTaps32Bits = 0b00000000_00000000_00010000_00001011
+
+// SSLFSR32 holds a 32 bit register
+type SSLFSR32 struct {
+	register uint32
+	interval uint32
+	counter  uint32
+}
+
+// NewSSLFSR32 constructs an SSLFSR32 with a given interval
+func NewSSLFSR32(interval uint32) (sslfsr SSLFSR32) {
+	return SSLFSR32{
+		register: 1,
+		interval: interval,
+		counter:  0,
+	}
+}
+
+// BuildSSLFSR32 constructs an SSLFSR32 with a given register, interval, and counter
+func BuildSSLFSR32(register uint32, interval uint32, counter uint32) (sslfsr SSLFSR32) {
+	return SSLFSR32{
+		register: register,
+		interval: interval,
+		counter:  counter,
+	}
+}
+
+// GetRegister returns the current register value
+func (sslfsr *SSLFSR32) GetRegister() uint32 {
+	return sslfsr.register
+}
+
+// GetInterval returns the interval this SSLFSR32 was constructed with
+func (sslfsr *SSLFSR32) GetInterval() uint32 {
+	return sslfsr.interval
+}
+
+// GetCounter returns the current counter value
+func (sslfsr *SSLFSR32) GetCounter() uint32 {
+	return sslfsr.counter
+}
+
+// Next Shifts or SubShifts according to the Counter and Interval and updates Counter accordingly
+func (sslfsr *SSLFSR32) Next() {
+	if sslfsr.counter == sslfsr.interval {
+		sslfsr.SubShift()
+		sslfsr.counter = 0
+	} else {
+		sslfsr.Shift()
+		sslfsr.counter++
+	}
+}
+
+// Shift modifies register by applying a standard LFSR shift to it
+func (sslfsr *SSLFSR32) Shift() {
+	sslfsr.register = Shift32Bits(sslfsr.register)
+}
+
+// Shift modifies register by applying a standard LFSR shift to it
+func Shift32Bits(register uint32) (result uint32) {
+	taps := uint32(Taps32Bits)
+
+	bit := bits.OnesCount32(register&taps)%2 == 1
+
+	result = register >> 1
+	if bit {
+		result = result | 0x80000000
+	}
+
+	return result
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just it's lower bits
+func (sslfsr *SSLFSR32) SubShift() {
+	sslfsr.register = SubShift32Bits(sslfsr.register)
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just it's lower bits
+func SubShift32Bits(register uint32) (result uint32) {
+	taps := uint32(SubTaps32Bits)
+
+	bit := bits.OnesCount32(register&taps)%2 == 1
+
+	higher := register & 0xFFFF0000
+	lower := register & 0x0000FFFF
+
+	lower = lower >> 1
+	if bit {
+		lower = lower | 0x8000
+	}
+
+	return higher | lower
+}
+
+// CalculateExpectedMaximalLength calculates the total state count if the SSLFSRs Interval were an optimal Interval
+func (sslfsr *SSLFSR32) CalculateExpectedMaximalLength() (stateCount uint64) {
+	return CalculateExpectedMaximalLength32Bits(sslfsr.interval)
+}
+
+// CalculateExpectedMaximalLength32Bits calculates the total state count if the SSLFSRs Interval were an optimal
+// Interval. Unlike the narrower registers this needs a uint64, (2^32-1)*(2^32) only just fits.
+func CalculateExpectedMaximalLength32Bits(interval uint32) (stateCount uint64) {
+	return math.MaxUint32 * (uint64(interval) + 1)
+}
+
+// VerifyInterval reports whether the SSLFSRs Interval is an optimal Interval, see VerifyInterval32Bits
+func (sslfsr *SSLFSR32) VerifyInterval() bool {
+	return VerifyInterval32Bits(sslfsr.interval)
+}
+
+// VerifyInterval32Bits reports whether interval is an optimal Interval by proving Next() takes
+// CalculateExpectedMaximalLength32Bits(interval) calls to return to its starting state, without making them
+func VerifyInterval32Bits(interval uint32) bool {
+	shift := func(register uint64) uint64 {
+		return uint64(Shift32Bits(uint32(register)))
+	}
+	subshift := func(register uint64) uint64 {
+		return uint64(SubShift32Bits(uint32(register)))
+	}
+
+	return verifyInterval(32, shift, subshift, uint64(interval))
+}
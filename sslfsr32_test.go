@@ -0,0 +1,70 @@
+package sslfsr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/coreyog/sslfsr/internal/gf2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettersAndGetters32Bits(t *testing.T) {
+	t.Parallel()
+
+	reg := BuildSSLFSR32(1, 2, 3)
+
+	assert.Equal(t, uint32(1), reg.GetRegister())
+	assert.Equal(t, uint32(2), reg.GetInterval())
+	assert.Equal(t, uint32(3), reg.GetCounter())
+}
+
+func Test32BitShift(t *testing.T) {
+	t.Parallel()
+
+	// walking 2^32-1 shifts takes too long, the shift's matrix proves the cycle instead and a million
+	// shifts from a few registers are checked against its power
+	shift := gf2.FromFunc(32, func(register uint64) uint64 { return uint64(Shift32Bits(uint32(register))) })
+	assert.True(t, shift.CycleIs(1, math.MaxUint32), "4294967295 shifts should result in starting state")
+
+	const shifts = 1 << 20
+	power := shift.Pow(shifts)
+
+	for _, register := range []uint32{1, 0x80000000, 0xDEADBEEF, math.MaxUint32} {
+		reg := NewSSLFSR32(0) // not using interval
+		reg.register = register
+
+		for range shifts {
+			reg.Shift()
+		}
+
+		assert.Equal(t, uint32(power.Apply(uint64(register))), reg.register, "register %#x", register)
+	}
+}
+
+func Test32BitSubShift(t *testing.T) {
+	t.Parallel()
+	for i := uint64(0); i <= math.MaxUint32; i += 0x0F0F0F0F { // a spread of upper and lower halves
+		reg := NewSSLFSR32(0)
+		reg.register = uint32(i)
+
+		for range math.MaxUint16 {
+			reg.SubShift()
+		}
+
+		assert.Equal(t, uint32(i), reg.register, "65535 subshifts should result in starting state")
+	}
+}
+
+func TestCalculateExpectedMaximalLength32Bits(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, uint64(math.MaxUint32)*2, CalculateExpectedMaximalLength32Bits(1))
+	assert.Equal(t, uint64(math.MaxUint64-math.MaxUint32), CalculateExpectedMaximalLength32Bits(math.MaxUint32), "doesn't overflow")
+}
+
+func TestVerifyInterval32Bits(t *testing.T) {
+	t.Parallel()
+
+	// found by walking every register, interval 1 leaves register 1 on a cycle of 1,879,048,164
+	assert.False(t, VerifyInterval32Bits(1))
+}
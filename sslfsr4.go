@@ -1,18 +1,17 @@
 package sslfsr
 
-import "math/bits"
+import (
+	"math/bits"
+	"sort"
+)
 
 const MaxUint4 = 1<<4 - 1
 
-// Intervals4Bits returns a list of known optimum intervals
-func Intervals4Bits() (working []int) {
-	return []int{
-		1,
-		7,
-		11,
-		13,
-	}
-}
+// Taps4Bits are the feedback taps Shift4Bits applies to the whole register
+const Taps4Bits = 0b0011
+
+// SubTaps4Bits are the feedback taps SubShift4Bits applies to the lower 2 bits
+const SubTaps4Bits = 0b0011
 
 // SSLFSR4 manages a 4 bit register
 type SSLFSR4 struct {
@@ -72,7 +71,7 @@ func (sslfsr *SSLFSR4) Shift() {
 
 // Shift modifies register by applying a standard LFSR shift to it
 func Shift4Bits(register uint8) uint8 {
-	taps := byte(0b0011)
+	taps := byte(Taps4Bits)
 	bit := bits.OnesCount8(register&taps)%2 == 1
 
 	register = register >> 1
@@ -90,7 +89,7 @@ func (sslfsr *SSLFSR4) SubShift() {
 
 // SubShift modifies register by applying a standard LFSR shift to just it's lower bits
 func SubShift4Bits(register uint8) uint8 {
-	taps := byte(0b0011)
+	taps := byte(SubTaps4Bits)
 	bit := bits.OnesCount8(register&taps)%2 == 1
 
 	higher := register & 0x0C
@@ -115,3 +114,81 @@ func (sslfsr *SSLFSR4) CalculateExpectedMaximalLength() (stateCount int) {
 func CalculateExpectedMaximalLength4Bits(interval uint8) (stateCount int) {
 	return 15 * (int(interval) + 1) // (2^4-1)*(interval+1)
 }
+
+// VerifyInterval reports whether the SSLFSRs Interval is an optimal Interval, see VerifyInterval4Bits
+func (sslfsr *SSLFSR4) VerifyInterval() bool {
+	return VerifyInterval4Bits(sslfsr.interval)
+}
+
+// VerifyInterval4Bits reports whether interval is an optimal Interval by proving Next() takes
+// CalculateExpectedMaximalLength4Bits(interval) calls to return to its starting state, without making them
+func VerifyInterval4Bits(interval uint8) bool {
+	shift := func(register uint64) uint64 {
+		return uint64(Shift4Bits(uint8(register)))
+	}
+	subshift := func(register uint64) uint64 {
+		return uint64(SubShift4Bits(uint8(register)))
+	}
+
+	return verifyInterval(4, shift, subshift, uint64(interval))
+}
+
+var optimalIntervals4Bits = newIntervalSet(Intervals4Bits())
+
+// IsOptimalInterval4Bits reports whether interval is one of Intervals4Bits without allocating
+func IsOptimalInterval4Bits(interval uint8) bool {
+	return optimalIntervals4Bits.contains(int(interval))
+}
+
+// CountOptimalIntervals4Bits returns how many intervals Intervals4Bits lists
+func CountOptimalIntervals4Bits() int {
+	return optimalIntervals4Bits.count()
+}
+
+// NthOptimalInterval4Bits returns the nth smallest of Intervals4Bits counting from 0, ok is false
+// when there are n or fewer
+func NthOptimalInterval4Bits(n int) (interval uint8, ok bool) {
+	nth, ok := optimalIntervals4Bits.nth(n)
+
+	return uint8(nth), ok
+}
+
+// NearestOptimalInterval4Bits returns the interval of Intervals4Bits closest to interval, the
+// smaller of two equally close ones
+func NearestOptimalInterval4Bits(interval uint8) uint8 {
+	nearest, _ := optimalIntervals4Bits.nearest(int(interval))
+
+	return uint8(nearest)
+}
+
+// ChooseInterval4Bits returns the smallest interval of Intervals4Bits whose period,
+// CalculateExpectedMaximalLength4Bits, is at least minPeriod. ok is false when none is that long.
+func ChooseInterval4Bits(minPeriod int) (interval uint8, ok bool) {
+	n := sort.Search(CountOptimalIntervals4Bits(), func(n int) bool {
+		interval, _ := NthOptimalInterval4Bits(n)
+		return CalculateExpectedMaximalLength4Bits(interval) >= minPeriod
+	})
+
+	return NthOptimalInterval4Bits(n)
+}
+
+// IntervalFromSeed4Bits derives one of Intervals4Bits from arbitrary seed bytes, the same seed always
+// giving the same interval
+func IntervalFromSeed4Bits(seed []byte) (interval uint8) {
+	_, word := seedWords(seed)
+	interval, _ = NthOptimalInterval4Bits(int(word % uint64(CountOptimalIntervals4Bits())))
+
+	return interval
+}
+
+// NewSSLFSR4FromSeed constructs an SSLFSR4 whose register and interval are derived from arbitrary
+// seed bytes. The register is never 0, which would never change, and the interval is always optimal.
+func NewSSLFSR4FromSeed(seed []byte) (sslfsr SSLFSR4) {
+	register, _ := seedWords(seed)
+
+	return SSLFSR4{
+		register: uint8(register%MaxUint4 + 1),
+		interval: IntervalFromSeed4Bits(seed),
+		counter:  0,
+	}
+}
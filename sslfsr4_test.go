@@ -2,6 +2,7 @@ package sslfsr
 
 import (
 	"slices"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -88,3 +89,111 @@ func TestNonIntervals4Bits(t *testing.T) {
 		assert.NotEqual(t, reg.CalculateExpectedMaximalLength(), count, "interval %d", i)
 	}
 }
+
+func TestVerifyInterval4Bits(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i <= MaxUint4; i++ {
+		reg := NewSSLFSR4(uint8(i))
+		start := reg.register
+
+		reg.Next()
+		count := 1
+		for reg.register != start || reg.counter != 0 {
+			reg.Next()
+			count++
+		}
+
+		assert.Equal(t, reg.CalculateExpectedMaximalLength() == count, reg.VerifyInterval(), "interval %d", i)
+	}
+}
+
+func TestOptimalIntervalLookups4Bits(t *testing.T) {
+	t.Parallel()
+
+	intervals := Intervals4Bits()
+	assert.Equal(t, len(intervals), CountOptimalIntervals4Bits())
+
+	for n, expected := range intervals {
+		interval, ok := NthOptimalInterval4Bits(n)
+		assert.True(t, ok)
+		assert.Equal(t, uint8(expected), interval)
+	}
+	_, ok := NthOptimalInterval4Bits(len(intervals))
+	assert.False(t, ok)
+
+	for i := range MaxUint4 + 1 {
+		above := sort.SearchInts(intervals, i)
+		assert.Equal(t, above < len(intervals) && intervals[above] == i, IsOptimalInterval4Bits(uint8(i)), "interval %d", i)
+
+		// the closer of the intervals either side of i, the smaller when they're as close
+		expected := intervals[min(above, len(intervals)-1)]
+		if above > 0 && (above == len(intervals) || i-intervals[above-1] <= intervals[above]-i) {
+			expected = intervals[above-1]
+		}
+		assert.Equal(t, uint8(expected), NearestOptimalInterval4Bits(uint8(i)), "interval %d", i)
+	}
+}
+
+func BenchmarkIsOptimalInterval4Bits(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		_ = IsOptimalInterval4Bits(uint8(i))
+	}
+}
+
+func BenchmarkNearestOptimalInterval4Bits(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		_ = NearestOptimalInterval4Bits(uint8(i))
+	}
+}
+
+func TestChooseInterval4Bits(t *testing.T) {
+	t.Parallel()
+
+	intervals := Intervals4Bits()
+
+	interval, ok := ChooseInterval4Bits(0)
+	assert.True(t, ok)
+	assert.Equal(t, uint8(intervals[0]), interval)
+
+	for n, expected := range intervals {
+		period := CalculateExpectedMaximalLength4Bits(uint8(expected))
+
+		interval, ok := ChooseInterval4Bits(period)
+		assert.True(t, ok)
+		assert.Equal(t, uint8(expected), interval, "a period of exactly %d", period)
+
+		interval, ok = ChooseInterval4Bits(period + 1)
+		if n+1 < len(intervals) {
+			assert.True(t, ok)
+			assert.Equal(t, uint8(intervals[n+1]), interval, "a period over %d", period)
+		} else {
+			assert.False(t, ok, "no interval is longer than the last")
+		}
+	}
+}
+
+func TestNewSSLFSR4FromSeed(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, NewSSLFSR4FromSeed([]byte("key")), NewSSLFSR4FromSeed([]byte("key")), "seeds are deterministic")
+
+	registers := map[uint8]bool{}
+	for i := range 2000 {
+		seed := []byte{byte(i), byte(i >> 8)}
+		reg := NewSSLFSR4FromSeed(seed)
+
+		assert.NotZero(t, reg.GetRegister())
+		assert.Zero(t, reg.GetCounter())
+		assert.True(t, IsOptimalInterval4Bits(reg.GetInterval()), "interval %d", reg.GetInterval())
+		assert.Equal(t, IntervalFromSeed4Bits(seed), reg.GetInterval())
+
+		registers[reg.GetRegister()] = true
+	}
+
+	assert.Greater(t, len(registers), min(MaxUint4, 2000)/2, "registers are spread out")
+}
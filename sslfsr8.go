@@ -3,8 +3,15 @@ package sslfsr
 import (
 	"math"
 	"math/bits"
+	"sort"
 )
 
+// Taps8Bits are the feedback taps Shift8Bits applies to the whole register
+const Taps8Bits = 0b00011101
+
+// SubTaps8Bits are the feedback taps SubShift8Bits applies to the lower 4 bits
+const SubTaps8Bits = 0b00000011
+
 // SSLFSR8 holds an 8 bit register, it's interval, and a counter
 type SSLFSR8 struct {
 	register uint8
@@ -12,33 +19,6 @@ type SSLFSR8 struct {
 	counter  uint8
 }
 
-// Intervals8Bits returns a list of known optimum intervals
-func Intervals8Bits() (working []int) {
-	return []int{
-		1, //?
-		11,
-		29,
-		63,
-		68,
-		83,
-		104,
-		106,
-		129,
-		134,
-		150,
-		155,
-		170,
-		176,
-		177,
-		192,
-		195,
-		202,
-		225,
-		237,
-		253,
-	}
-}
-
 // NewSSLFSR8 constructs an SSLFSR8 with a given interval
 func NewSSLFSR8(interval uint8) (sslfsr SSLFSR8) {
 	return SSLFSR8{
@@ -90,7 +70,7 @@ func (sslfsr *SSLFSR8) Shift() {
 
 // Shift modifies register by applying a standard LFSR shift to it
 func Shift8Bits(register uint8) uint8 {
-	taps := byte(0b00011101)
+	taps := byte(Taps8Bits)
 	bit := bits.OnesCount8(register&taps)%2 == 1
 
 	register = register >> 1
@@ -108,7 +88,7 @@ func (sslfsr *SSLFSR8) SubShift() {
 
 // SubShift modifies register by applying a standard LFSR shift to just it's lower bits
 func SubShift8Bits(register uint8) uint8 {
-	taps := byte(0b00000011)
+	taps := byte(SubTaps8Bits)
 	bit := bits.OnesCount8(register&taps)%2 == 1
 	higher := register & 0xF0
 	lower := register & 0x0F
@@ -129,3 +109,81 @@ func (sslfsr *SSLFSR8) CalculateExpectedMaximalLength() (stateCount int) {
 func CalculateExpectedMaximalLength8Bits(interval uint8) (stateCount int) {
 	return math.MaxUint8 * (int(interval) + 1)
 }
+
+// VerifyInterval reports whether the SSLFSRs Interval is an optimal Interval, see VerifyInterval8Bits
+func (sslfsr *SSLFSR8) VerifyInterval() bool {
+	return VerifyInterval8Bits(sslfsr.interval)
+}
+
+// VerifyInterval8Bits reports whether interval is an optimal Interval by proving Next() takes
+// CalculateExpectedMaximalLength8Bits(interval) calls to return to its starting state, without making them
+func VerifyInterval8Bits(interval uint8) bool {
+	shift := func(register uint64) uint64 {
+		return uint64(Shift8Bits(uint8(register)))
+	}
+	subshift := func(register uint64) uint64 {
+		return uint64(SubShift8Bits(uint8(register)))
+	}
+
+	return verifyInterval(8, shift, subshift, uint64(interval))
+}
+
+var optimalIntervals8Bits = newIntervalSet(Intervals8Bits())
+
+// IsOptimalInterval8Bits reports whether interval is one of Intervals8Bits without allocating
+func IsOptimalInterval8Bits(interval uint8) bool {
+	return optimalIntervals8Bits.contains(int(interval))
+}
+
+// CountOptimalIntervals8Bits returns how many intervals Intervals8Bits lists
+func CountOptimalIntervals8Bits() int {
+	return optimalIntervals8Bits.count()
+}
+
+// NthOptimalInterval8Bits returns the nth smallest of Intervals8Bits counting from 0, ok is false
+// when there are n or fewer
+func NthOptimalInterval8Bits(n int) (interval uint8, ok bool) {
+	nth, ok := optimalIntervals8Bits.nth(n)
+
+	return uint8(nth), ok
+}
+
+// NearestOptimalInterval8Bits returns the interval of Intervals8Bits closest to interval, the
+// smaller of two equally close ones
+func NearestOptimalInterval8Bits(interval uint8) uint8 {
+	nearest, _ := optimalIntervals8Bits.nearest(int(interval))
+
+	return uint8(nearest)
+}
+
+// ChooseInterval8Bits returns the smallest interval of Intervals8Bits whose period,
+// CalculateExpectedMaximalLength8Bits, is at least minPeriod. ok is false when none is that long.
+func ChooseInterval8Bits(minPeriod int) (interval uint8, ok bool) {
+	n := sort.Search(CountOptimalIntervals8Bits(), func(n int) bool {
+		interval, _ := NthOptimalInterval8Bits(n)
+		return CalculateExpectedMaximalLength8Bits(interval) >= minPeriod
+	})
+
+	return NthOptimalInterval8Bits(n)
+}
+
+// IntervalFromSeed8Bits derives one of Intervals8Bits from arbitrary seed bytes, the same seed always
+// giving the same interval
+func IntervalFromSeed8Bits(seed []byte) (interval uint8) {
+	_, word := seedWords(seed)
+	interval, _ = NthOptimalInterval8Bits(int(word % uint64(CountOptimalIntervals8Bits())))
+
+	return interval
+}
+
+// NewSSLFSR8FromSeed constructs an SSLFSR8 whose register and interval are derived from arbitrary
+// seed bytes. The register is never 0, which would never change, and the interval is always optimal.
+func NewSSLFSR8FromSeed(seed []byte) (sslfsr SSLFSR8) {
+	register, _ := seedWords(seed)
+
+	return SSLFSR8{
+		register: uint8(register%math.MaxUint8 + 1),
+		interval: IntervalFromSeed8Bits(seed),
+		counter:  0,
+	}
+}
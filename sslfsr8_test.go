@@ -3,6 +3,7 @@ package sslfsr
 import (
 	"math"
 	"slices"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -89,3 +90,111 @@ func TestNonIntervals8Bits(t *testing.T) {
 		assert.NotEqual(t, reg.CalculateExpectedMaximalLength(), count)
 	}
 }
+
+func TestVerifyInterval8Bits(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i <= math.MaxUint8; i++ {
+		reg := NewSSLFSR8(uint8(i))
+		start := reg.register
+
+		reg.Next()
+		count := 1
+		for reg.register != start || reg.counter != 0 {
+			reg.Next()
+			count++
+		}
+
+		assert.Equal(t, reg.CalculateExpectedMaximalLength() == count, reg.VerifyInterval(), "interval %d", i)
+	}
+}
+
+func TestOptimalIntervalLookups8Bits(t *testing.T) {
+	t.Parallel()
+
+	intervals := Intervals8Bits()
+	assert.Equal(t, len(intervals), CountOptimalIntervals8Bits())
+
+	for n, expected := range intervals {
+		interval, ok := NthOptimalInterval8Bits(n)
+		assert.True(t, ok)
+		assert.Equal(t, uint8(expected), interval)
+	}
+	_, ok := NthOptimalInterval8Bits(len(intervals))
+	assert.False(t, ok)
+
+	for i := range math.MaxUint8 + 1 {
+		above := sort.SearchInts(intervals, i)
+		assert.Equal(t, above < len(intervals) && intervals[above] == i, IsOptimalInterval8Bits(uint8(i)), "interval %d", i)
+
+		// the closer of the intervals either side of i, the smaller when they're as close
+		expected := intervals[min(above, len(intervals)-1)]
+		if above > 0 && (above == len(intervals) || i-intervals[above-1] <= intervals[above]-i) {
+			expected = intervals[above-1]
+		}
+		assert.Equal(t, uint8(expected), NearestOptimalInterval8Bits(uint8(i)), "interval %d", i)
+	}
+}
+
+func BenchmarkIsOptimalInterval8Bits(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		_ = IsOptimalInterval8Bits(uint8(i))
+	}
+}
+
+func BenchmarkNearestOptimalInterval8Bits(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		_ = NearestOptimalInterval8Bits(uint8(i))
+	}
+}
+
+func TestChooseInterval8Bits(t *testing.T) {
+	t.Parallel()
+
+	intervals := Intervals8Bits()
+
+	interval, ok := ChooseInterval8Bits(0)
+	assert.True(t, ok)
+	assert.Equal(t, uint8(intervals[0]), interval)
+
+	for n, expected := range intervals {
+		period := CalculateExpectedMaximalLength8Bits(uint8(expected))
+
+		interval, ok := ChooseInterval8Bits(period)
+		assert.True(t, ok)
+		assert.Equal(t, uint8(expected), interval, "a period of exactly %d", period)
+
+		interval, ok = ChooseInterval8Bits(period + 1)
+		if n+1 < len(intervals) {
+			assert.True(t, ok)
+			assert.Equal(t, uint8(intervals[n+1]), interval, "a period over %d", period)
+		} else {
+			assert.False(t, ok, "no interval is longer than the last")
+		}
+	}
+}
+
+func TestNewSSLFSR8FromSeed(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, NewSSLFSR8FromSeed([]byte("key")), NewSSLFSR8FromSeed([]byte("key")), "seeds are deterministic")
+
+	registers := map[uint8]bool{}
+	for i := range 2000 {
+		seed := []byte{byte(i), byte(i >> 8)}
+		reg := NewSSLFSR8FromSeed(seed)
+
+		assert.NotZero(t, reg.GetRegister())
+		assert.Zero(t, reg.GetCounter())
+		assert.True(t, IsOptimalInterval8Bits(reg.GetInterval()), "interval %d", reg.GetInterval())
+		assert.Equal(t, IntervalFromSeed8Bits(seed), reg.GetInterval())
+
+		registers[reg.GetRegister()] = true
+	}
+
+	assert.Greater(t, len(registers), min(math.MaxUint8, 2000)/2, "registers are spread out")
+}
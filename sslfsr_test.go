@@ -0,0 +1,112 @@
+package sslfsr
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultTaps(t *testing.T) {
+	t.Parallel()
+
+	for width := 2; width <= 16; width++ {
+		taps, _, ok := DefaultTaps(width)
+		require.True(t, ok)
+
+		register := ShiftWidth(1, width, taps)
+		count := 1
+		for register != 1 {
+			register = ShiftWidth(register, width, taps)
+			count++
+		}
+
+		assert.Equal(t, 1<<width-1, count, "%d bit taps should be maximal", width)
+	}
+
+	taps, subTaps, _ := DefaultTaps(8)
+	assert.Equal(t, uint64(Taps8Bits), taps)
+	assert.Equal(t, uint64(SubTaps8Bits), subTaps)
+
+	taps, subTaps, _ = DefaultTaps(16)
+	assert.Equal(t, uint64(Taps16Bits), taps)
+	assert.Equal(t, uint64(SubTaps16Bits), subTaps)
+
+	_, _, ok := DefaultTaps(MaxWidth + 1)
+	assert.False(t, ok)
+	_, _, ok = DefaultTaps(1)
+	assert.False(t, ok)
+}
+
+func TestSSLFSRMatchesFixedWidths(t *testing.T) {
+	t.Parallel()
+
+	reg, err := NewSSLFSR(16, 13)
+	require.NoError(t, err)
+	fixed := NewSSLFSR16(13)
+
+	for range 100_000 {
+		reg.Next()
+		fixed.Next()
+
+		require.Equal(t, uint64(fixed.GetRegister()), reg.GetRegister())
+		require.Equal(t, uint64(fixed.GetCounter()), reg.GetCounter())
+	}
+
+	assert.Equal(t, uint64(fixed.CalculateExpectedMaximalLength()), reg.CalculateExpectedMaximalLength())
+}
+
+func TestBuildSSLFSR(t *testing.T) {
+	t.Parallel()
+
+	reg, err := BuildSSLFSR(12, 1, 2, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 12, reg.GetWidth())
+	assert.Equal(t, uint64(1), reg.GetRegister())
+	assert.Equal(t, uint64(2), reg.GetInterval())
+	assert.Equal(t, uint64(3), reg.GetCounter())
+
+	_, err = BuildSSLFSR(12, 1<<12, 2, 3)
+	assert.Error(t, err, "register too wide")
+
+	_, err = NewSSLFSR(MaxWidth+1, 1)
+	assert.Error(t, err)
+}
+
+func TestKnownIntervals(t *testing.T) {
+	t.Parallel()
+
+	for _, width := range []int{5, 6, 7} {
+		intervals, ok := KnownIntervals(width)
+		require.True(t, ok)
+
+		for interval := uint64(1); interval < 1<<width-1; interval++ {
+			reg, _ := NewSSLFSR(width, interval)
+
+			reg.Next()
+			count := uint64(1)
+			for reg.register != 1 || reg.counter != 0 {
+				reg.Next()
+				count++
+			}
+
+			optimal := count == reg.CalculateExpectedMaximalLength()
+			assert.Equal(t, optimal, slices.Contains(intervals, int(interval)), "%d bit interval %d", width, interval)
+		}
+	}
+
+	for _, width := range []int{10, 12, 14} {
+		intervals, ok := KnownIntervals(width)
+		require.True(t, ok)
+
+		for interval := uint64(1); interval < 1<<width-1; interval++ {
+			reg, _ := NewSSLFSR(width, interval)
+			assert.Equal(t, reg.VerifyInterval(), slices.Contains(intervals, int(interval)), "%d bit interval %d", width, interval)
+		}
+	}
+
+	_, ok := KnownIntervals(20)
+	assert.False(t, ok)
+}
@@ -0,0 +1,22 @@
+package sslfsr
+
+import "math/bits"
+
+// ShiftWidth applies a standard LFSR shift with the given taps to a width bit register, Shift8Bits is
+// ShiftWidth(register, 8, Taps8Bits)
+func ShiftWidth(register uint64, width int, taps uint64) (result uint64) {
+	bit := uint64(bits.OnesCount64(register&taps) % 2)
+
+	return register>>1 | bit<<(width-1)
+}
+
+// SubShiftWidth applies a standard LFSR shift with the given taps to just the lower subWidth bits of
+// a register, SubShift8Bits is SubShiftWidth(register, 4, SubTaps8Bits)
+func SubShiftWidth(register uint64, subWidth int, taps uint64) (result uint64) {
+	mask := uint64(1)<<subWidth - 1
+
+	higher := register &^ mask
+	lower := ShiftWidth(register&mask, subWidth, taps)
+
+	return higher | lower
+}
@@ -0,0 +1,32 @@
+package sslfsr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShiftWidth(t *testing.T) {
+	t.Parallel()
+
+	for i := range MaxUint4 + 1 {
+		assert.Equal(t, uint64(Shift4Bits(uint8(i))), ShiftWidth(uint64(i), 4, Taps4Bits))
+		assert.Equal(t, uint64(SubShift4Bits(uint8(i))), SubShiftWidth(uint64(i), 2, SubTaps4Bits))
+	}
+
+	for i := range math.MaxUint8 + 1 {
+		assert.Equal(t, uint64(Shift8Bits(uint8(i))), ShiftWidth(uint64(i), 8, Taps8Bits))
+		assert.Equal(t, uint64(SubShift8Bits(uint8(i))), SubShiftWidth(uint64(i), 4, SubTaps8Bits))
+	}
+
+	for i := range math.MaxUint16 + 1 {
+		assert.Equal(t, uint64(Shift16Bits(uint16(i))), ShiftWidth(uint64(i), 16, Taps16Bits))
+		assert.Equal(t, uint64(SubShift16Bits(uint16(i))), SubShiftWidth(uint64(i), 8, SubTaps16Bits))
+	}
+
+	for _, i := range []uint32{1, 0x80000000, 0xDEADBEEF, math.MaxUint32} {
+		assert.Equal(t, uint64(Shift32Bits(i)), ShiftWidth(uint64(i), 32, Taps32Bits))
+		assert.Equal(t, uint64(SubShift32Bits(i)), SubShiftWidth(uint64(i), 16, SubTaps32Bits))
+	}
+}
@@ -0,0 +1,18 @@
+package sslfsr
+
+import "github.com/coreyog/sslfsr/internal/gf2"
+
+// verifyInterval proves that Next() with the given interval takes (2^width-1)(interval+1) calls to
+// come back to where it started, without making them.
+//
+// Shift and SubShift are permutations, so Next() is a permutation of (register, counter) pairs and
+// every state comes back around. Starting from register 1 with a counter of 0, the counter is 0 again
+// every interval+1 calls with the register one step further around its cycle under the interval map,
+// SubShift after interval Shifts. The full period is interval+1 times the length of that cycle, which
+// is maximal exactly when the cycle holds every non-zero register. The interval map is linear over
+// GF(2), so the cycle length is checked with a few matrix powers.
+func verifyInterval(width int, shift func(uint64) uint64, subshift func(uint64) uint64, interval uint64) bool {
+	step := gf2.FromFunc(width, subshift).Mul(gf2.FromFunc(width, shift).Pow(interval))
+
+	return step.CycleIs(1, 1<<width-1)
+}
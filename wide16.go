@@ -0,0 +1,100 @@
+package sslfsr
+
+import "math"
+
+// WideSSLFSR16 holds a 16 bit register with a 32 bit interval and counter, so its interval isn't
+// limited by the register width the way SSLFSR16's is
+type WideSSLFSR16 struct {
+	register uint16
+	interval uint32
+	counter  uint32
+}
+
+// NewWideSSLFSR16 constructs a WideSSLFSR16 with a given interval
+func NewWideSSLFSR16(interval uint32) (sslfsr WideSSLFSR16) {
+	return WideSSLFSR16{
+		register: 1,
+		interval: interval,
+		counter:  0,
+	}
+}
+
+// BuildWideSSLFSR16 constructs a WideSSLFSR16 with a given register, interval, and counter
+func BuildWideSSLFSR16(register uint16, interval uint32, counter uint32) (sslfsr WideSSLFSR16) {
+	return WideSSLFSR16{
+		register: register,
+		interval: interval,
+		counter:  counter,
+	}
+}
+
+// GetRegister returns the current register value
+func (sslfsr *WideSSLFSR16) GetRegister() uint16 {
+	return sslfsr.register
+}
+
+// GetInterval returns the interval this WideSSLFSR16 was constructed with
+func (sslfsr *WideSSLFSR16) GetInterval() uint32 {
+	return sslfsr.interval
+}
+
+// GetCounter returns the current counter value
+func (sslfsr *WideSSLFSR16) GetCounter() uint32 {
+	return sslfsr.counter
+}
+
+// Next Shifts or SubShifts according to the Counter and Interval and updates Counter accordingly
+func (sslfsr *WideSSLFSR16) Next() {
+	if sslfsr.counter == sslfsr.interval {
+		sslfsr.SubShift()
+		sslfsr.counter = 0
+	} else {
+		sslfsr.Shift()
+		sslfsr.counter++
+	}
+}
+
+// Shift modifies register by applying a standard LFSR shift to it, see Shift16Bits
+func (sslfsr *WideSSLFSR16) Shift() {
+	sslfsr.register = Shift16Bits(sslfsr.register)
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just it's lower bits, see SubShift16Bits
+func (sslfsr *WideSSLFSR16) SubShift() {
+	sslfsr.register = SubShift16Bits(sslfsr.register)
+}
+
+// CalculateExpectedMaximalLength calculates the total state count if the WideSSLFSRs Interval were an optimal Interval
+func (sslfsr *WideSSLFSR16) CalculateExpectedMaximalLength() (stateCount uint64) {
+	return CalculateExpectedWideMaximalLength16Bits(sslfsr.interval)
+}
+
+// CalculateExpectedWideMaximalLength16Bits calculates the total state count if a WideSSLFSR16s Interval were an optimal Interval
+func CalculateExpectedWideMaximalLength16Bits(interval uint32) (stateCount uint64) {
+	return math.MaxUint16 * (uint64(interval) + 1) // (2^16-1)*(interval+1)
+}
+
+// VerifyInterval reports whether the WideSSLFSRs Interval is an optimal Interval, see VerifyWideInterval16Bits
+func (sslfsr *WideSSLFSR16) VerifyInterval() bool {
+	return VerifyWideInterval16Bits(sslfsr.interval)
+}
+
+// VerifyWideInterval16Bits reports whether interval is an optimal Interval by proving Next() takes
+// CalculateExpectedWideMaximalLength16Bits(interval) calls to return to its starting state, without making them
+func VerifyWideInterval16Bits(interval uint32) bool {
+	shift := func(register uint64) uint64 {
+		return uint64(Shift16Bits(uint16(register)))
+	}
+	subshift := func(register uint64) uint64 {
+		return uint64(SubShift16Bits(uint16(register)))
+	}
+
+	return verifyInterval(16, shift, subshift, uint64(interval))
+}
+
+// IsOptimalWideInterval16Bits reports whether interval mod 65535 is one of Intervals16Bits without allocating
+func IsOptimalWideInterval16Bits(interval uint32) bool {
+	reduced := interval % math.MaxUint16
+
+	return reduced != 0 && IsOptimalInterval16Bits(uint16(reduced))
+}
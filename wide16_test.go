@@ -0,0 +1,36 @@
+package sslfsr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWideSSLFSR16MatchesSSLFSR16(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range Intervals16Bits()[:3] {
+		narrow := NewSSLFSR16(uint16(interval))
+		wide := NewWideSSLFSR16(uint32(interval))
+
+		for range 1000 {
+			narrow.Next()
+			wide.Next()
+
+			assert.Equal(t, narrow.GetRegister(), wide.GetRegister())
+			assert.Equal(t, uint32(narrow.GetCounter()), wide.GetCounter())
+		}
+	}
+}
+
+func TestIsOptimalWideInterval16Bits(t *testing.T) {
+	t.Parallel()
+
+	// walking these periods would take billions of calls, so only the proofs are compared
+	for _, start := range []uint32{0, math.MaxUint16 - 1000, 5*math.MaxUint16 - 1000} {
+		for interval := start; interval < start+2000; interval++ {
+			assert.Equal(t, VerifyWideInterval16Bits(interval), IsOptimalWideInterval16Bits(interval), "interval %d", interval)
+		}
+	}
+}
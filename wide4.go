@@ -0,0 +1,98 @@
+package sslfsr
+
+// WideSSLFSR4 holds a 4 bit register with a 32 bit interval and counter, so its interval isn't
+// limited by the register width the way SSLFSR4's is
+type WideSSLFSR4 struct {
+	register uint8
+	interval uint32
+	counter  uint32
+}
+
+// NewWideSSLFSR4 constructs a WideSSLFSR4 with a given interval
+func NewWideSSLFSR4(interval uint32) (sslfsr WideSSLFSR4) {
+	return WideSSLFSR4{
+		register: 1,
+		interval: interval,
+		counter:  0,
+	}
+}
+
+// BuildWideSSLFSR4 constructs a WideSSLFSR4 with a given register, interval, and counter
+func BuildWideSSLFSR4(register uint8, interval uint32, counter uint32) (sslfsr WideSSLFSR4) {
+	return WideSSLFSR4{
+		register: register,
+		interval: interval,
+		counter:  counter,
+	}
+}
+
+// GetRegister returns the current register value
+func (sslfsr *WideSSLFSR4) GetRegister() uint8 {
+	return sslfsr.register
+}
+
+// GetInterval returns the interval this WideSSLFSR4 was constructed with
+func (sslfsr *WideSSLFSR4) GetInterval() uint32 {
+	return sslfsr.interval
+}
+
+// GetCounter returns the current counter value
+func (sslfsr *WideSSLFSR4) GetCounter() uint32 {
+	return sslfsr.counter
+}
+
+// Next Shifts or SubShifts according to the Counter and Interval and updates Counter accordingly
+func (sslfsr *WideSSLFSR4) Next() {
+	if sslfsr.counter == sslfsr.interval {
+		sslfsr.SubShift()
+		sslfsr.counter = 0
+	} else {
+		sslfsr.Shift()
+		sslfsr.counter++
+	}
+}
+
+// Shift modifies register by applying a standard LFSR shift to it, see Shift4Bits
+func (sslfsr *WideSSLFSR4) Shift() {
+	sslfsr.register = Shift4Bits(sslfsr.register)
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just it's lower bits, see SubShift4Bits
+func (sslfsr *WideSSLFSR4) SubShift() {
+	sslfsr.register = SubShift4Bits(sslfsr.register)
+}
+
+// CalculateExpectedMaximalLength calculates the total state count if the WideSSLFSRs Interval were an optimal Interval
+func (sslfsr *WideSSLFSR4) CalculateExpectedMaximalLength() (stateCount uint64) {
+	return CalculateExpectedWideMaximalLength4Bits(sslfsr.interval)
+}
+
+// CalculateExpectedWideMaximalLength4Bits calculates the total state count if a WideSSLFSR4s Interval were an optimal Interval
+func CalculateExpectedWideMaximalLength4Bits(interval uint32) (stateCount uint64) {
+	return MaxUint4 * (uint64(interval) + 1) // (2^4-1)*(interval+1)
+}
+
+// VerifyInterval reports whether the WideSSLFSRs Interval is an optimal Interval, see VerifyWideInterval4Bits
+func (sslfsr *WideSSLFSR4) VerifyInterval() bool {
+	return VerifyWideInterval4Bits(sslfsr.interval)
+}
+
+// VerifyWideInterval4Bits reports whether interval is an optimal Interval by proving Next() takes
+// CalculateExpectedWideMaximalLength4Bits(interval) calls to return to its starting state, without making them
+func VerifyWideInterval4Bits(interval uint32) bool {
+	shift := func(register uint64) uint64 {
+		return uint64(Shift4Bits(uint8(register)))
+	}
+	subshift := func(register uint64) uint64 {
+		return uint64(SubShift4Bits(uint8(register)))
+	}
+
+	return verifyInterval(4, shift, subshift, uint64(interval))
+}
+
+// IsOptimalWideInterval4Bits reports whether interval mod 15 is one of Intervals4Bits without allocating
+func IsOptimalWideInterval4Bits(interval uint32) bool {
+	reduced := interval % MaxUint4
+
+	return reduced != 0 && IsOptimalInterval4Bits(uint8(reduced))
+}
@@ -0,0 +1,52 @@
+package sslfsr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWideSSLFSR4MatchesSSLFSR4(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range Intervals4Bits()[:3] {
+		narrow := NewSSLFSR4(uint8(interval))
+		wide := NewWideSSLFSR4(uint32(interval))
+
+		for range 1000 {
+			narrow.Next()
+			wide.Next()
+
+			assert.Equal(t, narrow.GetRegister(), wide.GetRegister())
+			assert.Equal(t, uint32(narrow.GetCounter()), wide.GetCounter())
+		}
+	}
+}
+
+func TestWideIntervals4Bits(t *testing.T) {
+	t.Parallel()
+
+	first := uint32(Intervals4Bits()[0])
+
+	for _, interval := range []uint32{first + MaxUint4, first + 3*MaxUint4, MaxUint4, MaxUint4 + 2} {
+		reg := NewWideSSLFSR4(interval)
+		start := reg.GetRegister()
+
+		reg.Next()
+		count := uint64(1)
+		for reg.GetRegister() != start || reg.GetCounter() != 0 {
+			reg.Next()
+			count++
+		}
+
+		assert.Equal(t, IsOptimalWideInterval4Bits(interval), reg.CalculateExpectedMaximalLength() == count, "interval %d", interval)
+	}
+}
+
+func TestIsOptimalWideInterval4Bits(t *testing.T) {
+	t.Parallel()
+
+	for interval := range uint32(MaxUint4 * 6) {
+		assert.Equal(t, VerifyWideInterval4Bits(interval), IsOptimalWideInterval4Bits(interval), "interval %d", interval)
+	}
+}
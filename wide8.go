@@ -0,0 +1,100 @@
+package sslfsr
+
+import "math"
+
+// WideSSLFSR8 holds a 8 bit register with a 32 bit interval and counter, so its interval isn't
+// limited by the register width the way SSLFSR8's is
+type WideSSLFSR8 struct {
+	register uint8
+	interval uint32
+	counter  uint32
+}
+
+// NewWideSSLFSR8 constructs a WideSSLFSR8 with a given interval
+func NewWideSSLFSR8(interval uint32) (sslfsr WideSSLFSR8) {
+	return WideSSLFSR8{
+		register: 1,
+		interval: interval,
+		counter:  0,
+	}
+}
+
+// BuildWideSSLFSR8 constructs a WideSSLFSR8 with a given register, interval, and counter
+func BuildWideSSLFSR8(register uint8, interval uint32, counter uint32) (sslfsr WideSSLFSR8) {
+	return WideSSLFSR8{
+		register: register,
+		interval: interval,
+		counter:  counter,
+	}
+}
+
+// GetRegister returns the current register value
+func (sslfsr *WideSSLFSR8) GetRegister() uint8 {
+	return sslfsr.register
+}
+
+// GetInterval returns the interval this WideSSLFSR8 was constructed with
+func (sslfsr *WideSSLFSR8) GetInterval() uint32 {
+	return sslfsr.interval
+}
+
+// GetCounter returns the current counter value
+func (sslfsr *WideSSLFSR8) GetCounter() uint32 {
+	return sslfsr.counter
+}
+
+// Next Shifts or SubShifts according to the Counter and Interval and updates Counter accordingly
+func (sslfsr *WideSSLFSR8) Next() {
+	if sslfsr.counter == sslfsr.interval {
+		sslfsr.SubShift()
+		sslfsr.counter = 0
+	} else {
+		sslfsr.Shift()
+		sslfsr.counter++
+	}
+}
+
+// Shift modifies register by applying a standard LFSR shift to it, see Shift8Bits
+func (sslfsr *WideSSLFSR8) Shift() {
+	sslfsr.register = Shift8Bits(sslfsr.register)
+}
+
+// SubShift modifies register by applying a standard LFSR shift to just it's lower bits, see SubShift8Bits
+func (sslfsr *WideSSLFSR8) SubShift() {
+	sslfsr.register = SubShift8Bits(sslfsr.register)
+}
+
+// CalculateExpectedMaximalLength calculates the total state count if the WideSSLFSRs Interval were an optimal Interval
+func (sslfsr *WideSSLFSR8) CalculateExpectedMaximalLength() (stateCount uint64) {
+	return CalculateExpectedWideMaximalLength8Bits(sslfsr.interval)
+}
+
+// CalculateExpectedWideMaximalLength8Bits calculates the total state count if a WideSSLFSR8s Interval were an optimal Interval
+func CalculateExpectedWideMaximalLength8Bits(interval uint32) (stateCount uint64) {
+	return math.MaxUint8 * (uint64(interval) + 1) // (2^8-1)*(interval+1)
+}
+
+// VerifyInterval reports whether the WideSSLFSRs Interval is an optimal Interval, see VerifyWideInterval8Bits
+func (sslfsr *WideSSLFSR8) VerifyInterval() bool {
+	return VerifyWideInterval8Bits(sslfsr.interval)
+}
+
+// VerifyWideInterval8Bits reports whether interval is an optimal Interval by proving Next() takes
+// CalculateExpectedWideMaximalLength8Bits(interval) calls to return to its starting state, without making them
+func VerifyWideInterval8Bits(interval uint32) bool {
+	shift := func(register uint64) uint64 {
+		return uint64(Shift8Bits(uint8(register)))
+	}
+	subshift := func(register uint64) uint64 {
+		return uint64(SubShift8Bits(uint8(register)))
+	}
+
+	return verifyInterval(8, shift, subshift, uint64(interval))
+}
+
+// IsOptimalWideInterval8Bits reports whether interval mod 255 is one of Intervals8Bits without allocating
+func IsOptimalWideInterval8Bits(interval uint32) bool {
+	reduced := interval % math.MaxUint8
+
+	return reduced != 0 && IsOptimalInterval8Bits(uint8(reduced))
+}
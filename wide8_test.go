@@ -0,0 +1,53 @@
+package sslfsr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWideSSLFSR8MatchesSSLFSR8(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range Intervals8Bits()[:3] {
+		narrow := NewSSLFSR8(uint8(interval))
+		wide := NewWideSSLFSR8(uint32(interval))
+
+		for range 1000 {
+			narrow.Next()
+			wide.Next()
+
+			assert.Equal(t, narrow.GetRegister(), wide.GetRegister())
+			assert.Equal(t, uint32(narrow.GetCounter()), wide.GetCounter())
+		}
+	}
+}
+
+func TestWideIntervals8Bits(t *testing.T) {
+	t.Parallel()
+
+	first := uint32(Intervals8Bits()[0])
+
+	for _, interval := range []uint32{first + math.MaxUint8, first + 3*math.MaxUint8, math.MaxUint8, math.MaxUint8 + 2} {
+		reg := NewWideSSLFSR8(interval)
+		start := reg.GetRegister()
+
+		reg.Next()
+		count := uint64(1)
+		for reg.GetRegister() != start || reg.GetCounter() != 0 {
+			reg.Next()
+			count++
+		}
+
+		assert.Equal(t, IsOptimalWideInterval8Bits(interval), reg.CalculateExpectedMaximalLength() == count, "interval %d", interval)
+	}
+}
+
+func TestIsOptimalWideInterval8Bits(t *testing.T) {
+	t.Parallel()
+
+	for interval := range uint32(math.MaxUint8 * 4) {
+		assert.Equal(t, VerifyWideInterval8Bits(interval), IsOptimalWideInterval8Bits(interval), "interval %d", interval)
+	}
+}